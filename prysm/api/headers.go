@@ -7,9 +7,18 @@ const (
 	ExecutionPayloadBlindedHeader = "Eth-Execution-Payload-Blinded"
 	ExecutionPayloadValueHeader   = "Eth-Execution-Payload-Value"
 	ConsensusBlockValueHeader     = "Eth-Consensus-Block-Value"
+	PoolSizeHeader                = "X-Pool-Size"
+	ResponseTruncatedHeader       = "X-Response-Truncated"
+	TotalCountHeader              = "X-Total-Count"
+	ReceiptIDHeader               = "X-Receipt-Id"
+	SubmissionIDHeader            = "X-Submission-Id"
+	VerificationStateSlotHeader   = "X-Verification-State-Slot"
 	JsonMediaType                 = "application/json"
 	OctetStreamMediaType          = "application/octet-stream"
 	EventStreamMediaType          = "text/event-stream"
+	NdjsonMediaType               = "application/x-ndjson"
+	MultipartFormDataMediaType    = "multipart/form-data"
+	ProtobufMediaType             = "application/x-protobuf"
 	KeepAlive                     = "keep-alive"
 )
 
@@ -1,11 +1,18 @@
 package middleware
 
 import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/rs/cors"
+	log "github.com/sirupsen/logrus"
 )
 
 type Middleware func(http.Handler) http.Handler
@@ -112,6 +119,161 @@ func AcceptHeaderHandler(serverAcceptedTypes []string) Middleware {
 	}
 }
 
+// PreferMinimalResponseHandler omits the response body when the client sends the `Prefer: return=minimal` header,
+// so that only the status code is returned.
+func PreferMinimalResponseHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !httputil.PreferMinimalResponse(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Preference-Applied", "return=minimal")
+		next.ServeHTTP(&minimalResponseWriter{ResponseWriter: w}, r)
+	})
+}
+
+// minimalResponseWriter discards the body of a response whose client only needs the status code.
+type minimalResponseWriter struct {
+	http.ResponseWriter
+}
+
+// WriteHeader removes the headers describing the discarded body before writing the status code.
+func (w *minimalResponseWriter) WriteHeader(code int) {
+	w.Header().Del("Content-Type")
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write discards the response body.
+func (w *minimalResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// Unwrap returns the wrapped response writer, so that http.ResponseController can reach it.
+func (w *minimalResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// CompressionHandler compresses response bodies of at least minSize bytes with gzip or deflate, depending on the
+// encodings accepted by the client in the `Accept-Encoding` header. Smaller responses, responses that are already
+// encoded and responses to clients that accept neither encoding are written unchanged.
+func CompressionHandler(minSize int) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			w.Header().Add("Vary", "Accept-Encoding")
+			if encoding == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bw := &bufferedResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(bw, r)
+
+			body := bw.body.Bytes()
+			if len(body) < minSize || w.Header().Get("Content-Encoding") != "" {
+				w.WriteHeader(bw.code)
+				if _, err := w.Write(body); err != nil {
+					log.WithError(err).Error("Could not write response message")
+				}
+				return
+			}
+
+			compressed, err := compress(encoding, body)
+			if err != nil {
+				log.WithError(err).Error("Could not compress response message")
+				w.WriteHeader(bw.code)
+				if _, err := w.Write(body); err != nil {
+					log.WithError(err).Error("Could not write response message")
+				}
+				return
+			}
+			w.Header().Set("Content-Encoding", encoding)
+			w.Header().Set("Content-Length", strconv.Itoa(len(compressed)))
+			w.WriteHeader(bw.code)
+			if _, err := w.Write(compressed); err != nil {
+				log.WithError(err).Error("Could not write response message")
+			}
+		})
+	}
+}
+
+// bufferedResponseWriter holds back the status code and the body of a response until the handler is done,
+// so that the body can be compressed as a whole.
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	code int
+	body bytes.Buffer
+}
+
+// WriteHeader records the status code without writing it.
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	w.code = code
+}
+
+// Write appends b to the buffered body.
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// negotiateEncoding returns the supported encoding with the highest quality value in the `Accept-Encoding` header,
+// preferring gzip over deflate on a tie. It returns an empty string when neither encoding is acceptable.
+func negotiateEncoding(acceptEncoding string) string {
+	best, bestQ := "", 0.0
+	// follows rules defined in https://datatracker.ietf.org/doc/html/rfc9110#section-12.5.3
+	for _, candidate := range []string{"gzip", "deflate"} {
+		q, found, wildcardQ := 0.0, false, -1.0
+		for _, part := range strings.Split(acceptEncoding, ",") {
+			name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name != candidate && name != "*" {
+				continue
+			}
+			partQ := 1.0
+			if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				parsed, err := strconv.ParseFloat(v, 64)
+				if err != nil {
+					continue
+				}
+				partQ = parsed
+			}
+			if name == "*" {
+				wildcardQ = partQ
+				continue
+			}
+			q, found = partQ, true
+		}
+		if !found && wildcardQ >= 0 {
+			q = wildcardQ
+		}
+		if q > bestQ {
+			best, bestQ = candidate, q
+		}
+	}
+	return best
+}
+
+func compress(encoding string, body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	var cw io.WriteCloser
+	switch encoding {
+	case "gzip":
+		cw = gzip.NewWriter(&buf)
+	case "deflate":
+		// the "deflate" content coding is the zlib format, see https://datatracker.ietf.org/doc/html/rfc9110#section-8.4.1.2
+		cw = zlib.NewWriter(&buf)
+	default:
+		return nil, fmt.Errorf("unsupported encoding %s", encoding)
+	}
+	if _, err := cw.Write(body); err != nil {
+		return nil, err
+	}
+	if err := cw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 func MiddlewareChain(h http.Handler, mw []Middleware) http.Handler {
 	if len(mw) < 1 {
 		return h
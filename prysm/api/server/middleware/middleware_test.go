@@ -1,11 +1,17 @@
 package middleware
 
 import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -202,3 +208,127 @@ func TestAcceptHeaderHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestPreferMinimalResponseHandler(t *testing.T) {
+	handler := PreferMinimalResponseHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httputil.HandleError(w, "something went wrong", http.StatusBadRequest)
+	}))
+
+	t.Run("minimal", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Prefer", "return=minimal")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, 0, rr.Body.Len())
+		assert.Equal(t, "", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "", rr.Header().Get("Content-Length"))
+		assert.Equal(t, "return=minimal", rr.Header().Get("Preference-Applied"))
+	})
+	t.Run("minimal flush", func(t *testing.T) {
+		handler := PreferMinimalResponseHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte("foo"))
+			require.NoError(t, err)
+			require.NoError(t, http.NewResponseController(w).Flush())
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Prefer", "return=minimal")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, 0, rr.Body.Len())
+		assert.Equal(t, true, rr.Flushed)
+	})
+	t.Run("no preference", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.StringContains(t, "something went wrong", rr.Body.String())
+		assert.Equal(t, "", rr.Header().Get("Preference-Applied"))
+	})
+}
+
+func TestCompressionHandler(t *testing.T) {
+	body := strings.Repeat("a", 100)
+	handler := CompressionHandler(50)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("small") != "" {
+			_, err := w.Write([]byte("a"))
+			require.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err)
+	}))
+
+	t.Run("gzip", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", "gzip, deflate")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Equal(t, "gzip", rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", rr.Header().Get("Vary"))
+		r, err := gzip.NewReader(rr.Body)
+		require.NoError(t, err)
+		decoded, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, body, string(decoded))
+	})
+	t.Run("deflate preferred", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", "gzip;q=0.5, deflate")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Equal(t, "deflate", rr.Header().Get("Content-Encoding"))
+		r, err := zlib.NewReader(rr.Body)
+		require.NoError(t, err)
+		decoded, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, body, string(decoded))
+	})
+	t.Run("wildcard", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", "*")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, "gzip", rr.Header().Get("Content-Encoding"))
+	})
+	t.Run("not accepted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", "br, gzip;q=0, *;q=0")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Equal(t, "", rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, body, rr.Body.String())
+	})
+	t.Run("no header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, "", rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, body, rr.Body.String())
+	})
+	t.Run("below threshold", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/?small=true", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "", rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, "a", rr.Body.String())
+	})
+}
@@ -2,6 +2,8 @@ package structs
 
 import (
 	"encoding/json"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server"
 )
 
 type BlockRootResponse struct {
@@ -21,22 +23,289 @@ type GetCommitteesResponse struct {
 }
 
 type ListAttestationsResponse struct {
+	Version  string          `json:"version,omitempty"`
+	HeadSlot string          `json:"head_slot,omitempty"`
+	HeadRoot string          `json:"head_root,omitempty"`
+	Total    string          `json:"total,omitempty"`
+	Data     json.RawMessage `json:"data"`
+}
+
+type AttestationPoolDiffResponse struct {
+	Version string          `json:"version,omitempty"`
+	Token   string          `json:"token"`
+	Added   json.RawMessage `json:"added"`
+	Removed []string        `json:"removed"`
+}
+
+type ListAttestationVotesByBlockRootResponse struct {
+	Data []*AttestationBlockRootVotes `json:"data"`
+}
+
+type AttestationBlockRootVotes struct {
+	BlockRoot        string `json:"block_root"`
+	ParticipantCount string `json:"participant_count"`
+	AttestationCount string `json:"attestation_count"`
+}
+
+type GetBestAttestationResponse struct {
 	Version string          `json:"version,omitempty"`
 	Data    json.RawMessage `json:"data"`
 }
 
+type GetPoolAttestationResponse struct {
+	Version string          `json:"version,omitempty"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type GetValidatorPoolStatusResponse struct {
+	Data *ValidatorPoolStatus `json:"data"`
+}
+
+type ValidatorPoolStatus struct {
+	ValidatorIndex              string `json:"validator_index"`
+	PendingVoluntaryExit        bool   `json:"pending_voluntary_exit"`
+	PendingBLSToExecutionChange bool   `json:"pending_bls_to_execution_change"`
+	PendingAttesterSlashing     bool   `json:"pending_attester_slashing"`
+	PendingProposerSlashing     bool   `json:"pending_proposer_slashing"`
+	PooledAttestation           *bool  `json:"pooled_attestation,omitempty"`
+}
+
+type GetAttestationPoolFormatCountsResponse struct {
+	Data *AttestationPoolFormatCounts `json:"data"`
+}
+
+type AttestationPoolFormatCounts struct {
+	PreElectra string `json:"pre_electra"`
+	Electra    string `json:"electra"`
+}
+
+type GetPoolStatsResponse struct {
+	Data *PoolStats `json:"data"`
+}
+
+type PoolStats struct {
+	AggregatedAttestations   string `json:"aggregated_attestations"`
+	UnaggregatedAttestations string `json:"unaggregated_attestations"`
+	VoluntaryExits           string `json:"voluntary_exits"`
+	AttesterSlashings        string `json:"attester_slashings"`
+	ProposerSlashings        string `json:"proposer_slashings"`
+	BLSToExecutionChanges    string `json:"bls_to_execution_changes"`
+}
+
+type GetAttestationPoolValidatorCountResponse struct {
+	Data *AttestationPoolValidatorCount `json:"data"`
+}
+
+type AttestationPoolValidatorCount struct {
+	ValidatorCount string `json:"validator_count"`
+}
+
+type GetAttestationPoolCoverageResponse struct {
+	Data []*CommitteeCoverage `json:"data"`
+}
+
+type CommitteeCoverage struct {
+	CommitteeIndex string `json:"committee_index"`
+	CommitteeSize  string `json:"committee_size"`
+	Covered        string `json:"covered"`
+	Coverage       string `json:"coverage"`
+}
+
+type GetBLSToExecutionChangesStatusResponse struct {
+	Data *BLSToExecutionChangesStatus `json:"data"`
+}
+
+type BLSToExecutionChangesStatus struct {
+	Valid                   string   `json:"valid"`
+	Invalid                 string   `json:"invalid"`
+	InvalidValidatorIndices []string `json:"invalid_validator_indices"`
+}
+
+type GetAttestationPoolSlotHistogramResponse struct {
+	Data map[string]string `json:"data"`
+}
+
+type GetAttestationPoolAgeResponse struct {
+	Data *AttestationPoolAge `json:"data"`
+}
+
+type AttestationPoolAge struct {
+	OldestSlot string `json:"oldest_slot"`
+	AgeSlots   string `json:"age_slots"`
+	AgeSeconds string `json:"age_seconds"`
+}
+
 type SubmitAttestationsRequest struct {
 	Data json.RawMessage `json:"data"`
 }
 
+type SubmitAttestationsResponse struct {
+	BroadcastDeferred bool                           `json:"broadcast_deferred"`
+	Statuses          []*AttestationSubmissionStatus `json:"statuses"`
+	ReceiptID         string                         `json:"receipt_id,omitempty"`
+}
+
+// SubmitAttestationsPartialResponse is returned when some, but not all, of the submitted attestations failed validation
+// or could not be broadcast. Accepted is the number of attestations that were broadcast or, when the broadcast is deferred,
+// saved to the pool, and their outcomes are reported in Statuses.
+// FailedBroadcasts lists the indices of the attestations that could not be broadcast. Aggregates is only set
+// by the endpoint that aggregates the submitted attestations.
+type SubmitAttestationsPartialResponse struct {
+	Message           string                               `json:"message"`
+	Accepted          string                               `json:"accepted"`
+	BroadcastDeferred bool                                 `json:"broadcast_deferred"`
+	Statuses          []*AttestationSubmissionStatus       `json:"statuses"`
+	Failures          []*server.IndexedVerificationFailure `json:"failures"`
+	FailedBroadcasts  []string                             `json:"failed_broadcasts"`
+	ReceiptID         string                               `json:"receipt_id,omitempty"`
+	Aggregates        []*SubmittedAttestationsAggregate    `json:"aggregates,omitempty"`
+}
+
+type SubmitAttestationsAndAggregateResponse struct {
+	BroadcastDeferred bool                              `json:"broadcast_deferred"`
+	Statuses          []*AttestationSubmissionStatus    `json:"statuses"`
+	Aggregates        []*SubmittedAttestationsAggregate `json:"aggregates"`
+}
+
+type SubmittedAttestationsAggregate struct {
+	Indices []string `json:"indices"`
+	Ssz     string   `json:"ssz"`
+}
+
+type GetAttestationReceiptResponse struct {
+	Data *AttestationReceipt `json:"data"`
+}
+
+type AttestationReceipt struct {
+	ReceiptID string                       `json:"receipt_id"`
+	Outcomes  []*AttestationReceiptOutcome `json:"outcomes"`
+}
+
+type AttestationReceiptOutcome struct {
+	Index   string `json:"index"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+type SubmitAggregateAndProofsResponse struct {
+	Statuses []*AttestationSubmissionStatus `json:"statuses"`
+}
+
+// SubmitAggregateAndProofsFailureResponse is returned when one or more of the submitted aggregate and proofs failed
+// validation. The aggregates of the remaining aggregate and proofs are still saved to the pool and reported in Statuses.
+type SubmitAggregateAndProofsFailureResponse struct {
+	Code     int                                  `json:"code"`
+	Message  string                               `json:"message"`
+	Failures []*server.IndexedVerificationFailure `json:"failures"`
+	Statuses []*AttestationSubmissionStatus       `json:"statuses"`
+}
+
+func (r *SubmitAggregateAndProofsFailureResponse) StatusCode() int {
+	return r.Code
+}
+
+type AttestationSubmissionStatus struct {
+	Index            string `json:"index"`
+	Status           string `json:"status"`
+	Message          string `json:"message,omitempty"`
+	BroadcastFailure string `json:"broadcast_failure,omitempty"` // Whether an attestation that could not be broadcast was `retained` in the pool or `dropped`.
+}
+
+type SubmitAttestationsPublishTargetsResponse struct {
+	Data      []*AttestationPublishTargets   `json:"data"`
+	Statuses  []*AttestationSubmissionStatus `json:"statuses"`
+	ReceiptID string                         `json:"receipt_id,omitempty"`
+}
+
+type AttestationPublishTargets struct {
+	Index  string   `json:"index"`
+	Subnet string   `json:"subnet"`
+	Peers  []string `json:"peers"`
+	Error  string   `json:"error,omitempty"`
+}
+
+type ListBroadcastFailuresResponse struct {
+	Data []*BroadcastFailure `json:"data"`
+}
+
+type GetSubmissionRejectionsResponse struct {
+	// Window is the length of the rolling window in seconds.
+	Window string            `json:"window"`
+	Data   map[string]string `json:"data"`
+}
+
+type GetSlashingStatusResponse struct {
+	Data *SlashingStatus `json:"data"`
+}
+
+type SlashingStatus struct {
+	Root              string   `json:"root"`
+	Kind              string   `json:"kind"`
+	Status            string   `json:"status"`
+	Validators        []string `json:"validators"`
+	SlashedValidators []string `json:"slashed_validators"`
+}
+
+type BroadcastFailure struct {
+	Operation string `json:"operation"`
+	Index     string `json:"index"`
+	Root      string `json:"root"`
+	Subnet    string `json:"subnet,omitempty"`
+	Error     string `json:"error"`
+	Timestamp string `json:"timestamp"`
+}
+
+type ValidateAttestationsResponse struct {
+	Data []*AttestationValidationResult `json:"data"`
+}
+
+type AttestationValidationResult struct {
+	Index   string `json:"index"`
+	Valid   bool   `json:"valid"`
+	Message string `json:"message,omitempty"`
+}
+
 type ListVoluntaryExitsResponse struct {
 	Data []*SignedVoluntaryExit `json:"data"`
 }
 
+type ListVoluntaryExitsWithSSZResponse struct {
+	Data []*SignedVoluntaryExitWithSSZ `json:"data"`
+}
+
+// SignedVoluntaryExitWithSSZ is a voluntary exit accompanied by its base64-encoded SSZ serialization.
+type SignedVoluntaryExitWithSSZ struct {
+	*SignedVoluntaryExit
+	SSZ string `json:"ssz"`
+}
+
+// AttestationWithSSZ is an attestation accompanied by its base64-encoded SSZ serialization.
+type AttestationWithSSZ struct {
+	*Attestation
+	SSZ    string `json:"ssz,omitempty"`
+	Source string `json:"source,omitempty"`
+}
+
+// AttestationElectraWithSSZ is an Electra attestation accompanied by its base64-encoded SSZ serialization.
+type AttestationElectraWithSSZ struct {
+	*AttestationElectra
+	SSZ    string `json:"ssz,omitempty"`
+	Source string `json:"source,omitempty"`
+}
+
 type SubmitSyncCommitteeSignaturesRequest struct {
 	Data []*SyncCommitteeMessage `json:"data"`
 }
 
+type SubmitSyncCommitteeSignaturesResponse struct {
+	Duplicates []*SyncCommitteeMessageDuplicate `json:"duplicates"`
+}
+
+type SyncCommitteeMessageDuplicate struct {
+	Index       string `json:"index"`
+	DuplicateOf string `json:"duplicate_of"`
+}
+
 type GetStateForkResponse struct {
 	Data                *Fork `json:"data"`
 	ExecutionOptimistic bool  `json:"execution_optimistic"`
@@ -176,13 +445,72 @@ type BLSToExecutionChangesPoolResponse struct {
 	Data []*SignedBLSToExecutionChange `json:"data"`
 }
 
+type SubmitAttesterSlashingResponse struct {
+	Condition string `json:"condition"`
+}
+
 type GetAttesterSlashingsResponse struct {
-	Version string          `json:"version,omitempty"`
-	Data    json.RawMessage `json:"data"` // Accepts both `[]*AttesterSlashing` and `[]*AttesterSlashingElectra` types
+	Version  string          `json:"version,omitempty"`
+	HeadSlot string          `json:"head_slot,omitempty"`
+	HeadRoot string          `json:"head_root,omitempty"`
+	Data     json.RawMessage `json:"data"` // Accepts both `[]*AttesterSlashing` and `[]*AttesterSlashingElectra` types
+}
+
+type DeleteVoluntaryExitResponse struct {
+	Data *SignedVoluntaryExit `json:"data"`
+}
+
+type GetVoluntaryExitQueueResponse struct {
+	Data *VoluntaryExitQueue `json:"data"`
+}
+
+type VoluntaryExitQueue struct {
+	ChurnLimit        string `json:"churn_limit,omitempty"`
+	ChurnLimitGwei    string `json:"churn_limit_gwei,omitempty"`
+	QueuedValidators  string `json:"queued_validators"`
+	EarliestExitEpoch string `json:"earliest_exit_epoch"`
+}
+
+type GetAttestationsForInclusionResponse struct {
+	Version string          `json:"version"`
+	Slot    string          `json:"slot"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type GetEligibleAttestationsResponse struct {
+	Version    string          `json:"version"`
+	TargetSlot string          `json:"target_slot"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type PruneSlashingsResponse struct {
+	Data *PrunedSlashings `json:"data"`
+}
+
+type PrunedSlashings struct {
+	AttesterSlashings string `json:"attester_slashings"`
+	ProposerSlashings string `json:"proposer_slashings"`
 }
 
 type GetProposerSlashingsResponse struct {
-	Data []*ProposerSlashing `json:"data"`
+	HeadSlot string              `json:"head_slot,omitempty"`
+	HeadRoot string              `json:"head_root,omitempty"`
+	Data     []*ProposerSlashing `json:"data"`
+}
+
+type ExportPoolResponse struct {
+	Version  string      `json:"version"`
+	HeadSlot string      `json:"head_slot"`
+	HeadRoot string      `json:"head_root"`
+	Data     *PoolExport `json:"data"`
+}
+
+type PoolExport struct {
+	Attestations          []interface{}                 `json:"attestations"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits"`
+	BLSToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes"`
+	AttesterSlashings     []interface{}                 `json:"attester_slashings"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings"`
 }
 
 type GetWeakSubjectivityResponse struct {
@@ -91,6 +91,15 @@ type BlobSidecarEvent struct {
 	VersionedHash string `json:"versioned_hash"`
 }
 
+type BLSToExecutionChangeBroadcastEvent struct {
+	SubmissionID            string   `json:"submission_id"`
+	ValidatorIndices        []string `json:"validator_indices"`
+	DroppedValidatorIndices []string `json:"dropped_validator_indices"`
+	FailedValidatorIndices  []string `json:"failed_validator_indices"`
+	Remaining               string   `json:"remaining"`
+	Complete                bool     `json:"complete"`
+}
+
 type LightClientFinalityUpdateEvent struct {
 	Version string                     `json:"version"`
 	Data    *LightClientFinalityUpdate `json:"data"`
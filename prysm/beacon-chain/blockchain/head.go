@@ -405,11 +405,11 @@ func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]b
 				continue
 			}
 			if helpers.IsAggregated(a) {
-				if err := s.cfg.AttPool.SaveAggregatedAttestation(a); err != nil {
+				if _, err := s.cfg.AttPool.SaveAggregatedAttestation(a); err != nil {
 					return err
 				}
 			} else {
-				if err := s.cfg.AttPool.SaveUnaggregatedAttestation(a); err != nil {
+				if _, err := s.cfg.AttPool.SaveUnaggregatedAttestation(a); err != nil {
 					return err
 				}
 			}
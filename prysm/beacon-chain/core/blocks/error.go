@@ -5,4 +5,7 @@ import "github.com/pkg/errors"
 var errNilSignedWithdrawalMessage = errors.New("nil SignedBLSToExecutionChange message")
 var errNilWithdrawalMessage = errors.New("nil BLSToExecutionChange message")
 var errInvalidBLSPrefix = errors.New("withdrawal credential prefix is not a BLS prefix")
-var errInvalidWithdrawalCredentials = errors.New("withdrawal credentials do not match")
+
+// ErrInvalidWithdrawalCredentials is returned when the public key of a BLS to execution change
+// does not hash to the withdrawal credentials of the validator.
+var ErrInvalidWithdrawalCredentials = errors.New("withdrawal credentials do not match")
@@ -110,7 +110,7 @@ func ValidateBLSToExecutionChange(st state.ReadOnlyBeaconState, signed *ethpb.Si
 	hashFn := ssz.NewHasherFunc(hash.CustomSHA256Hasher())
 	digest := hashFn.Hash(fromPubkey)
 	if !bytes.Equal(digest[1:], cred[1:]) {
-		return nil, errInvalidWithdrawalCredentials
+		return nil, ErrInvalidWithdrawalCredentials
 	}
 	return val, nil
 }
@@ -32,6 +32,14 @@ const (
 
 	// AttesterSlashingReceived is sent after an attester slashing is received from gossip or rpc
 	AttesterSlashingReceived = 8
+
+	// BLSToExecutionChangesBroadcast is sent after a batch of BLS to execution changes submitted over rpc
+	// has been broadcast to the network.
+	BLSToExecutionChangesBroadcast = 9
+
+	// UnaggregatedAttsReceived is sent after a batch of unaggregated attestations has been received
+	// from the outside world in a single submission. (eg. in RPC)
+	UnaggregatedAttsReceived = 10
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -40,10 +48,16 @@ type UnAggregatedAttReceivedData struct {
 	Attestation ethpb.Att
 }
 
+// UnAggregatedAttsReceivedData is the data sent with UnaggregatedAttsReceived events.
+type UnAggregatedAttsReceivedData struct {
+	// Attestations are the unaggregated attestation objects received in the same submission.
+	Attestations []ethpb.Att
+}
+
 // AggregatedAttReceivedData is the data sent with AggregatedAttReceived events.
 type AggregatedAttReceivedData struct {
 	// Attestation is the aggregated attestation object.
-	Attestation *ethpb.AggregateAttestationAndProof
+	Attestation ethpb.AggregateAttAndProof
 }
 
 // ExitReceivedData is the data sent with ExitReceived events.
@@ -77,3 +91,19 @@ type ProposerSlashingReceivedData struct {
 type AttesterSlashingReceivedData struct {
 	AttesterSlashing ethpb.AttSlashing
 }
+
+// BLSToExecutionChangesBroadcastData is the data sent with BLSToExecutionChangesBroadcast events.
+type BLSToExecutionChangesBroadcastData struct {
+	// SubmissionID identifies the submission that the batch belongs to.
+	SubmissionID string
+	// Changes are the BLS to execution changes which were broadcast in this batch.
+	Changes []*ethpb.SignedBLSToExecutionChange
+	// Dropped are the BLS to execution changes of this batch which were not broadcast
+	// because they were no longer valid against the head state.
+	Dropped []*ethpb.SignedBLSToExecutionChange
+	// Failed are the BLS to execution changes of this batch whose broadcast failed.
+	Failed []*ethpb.SignedBLSToExecutionChange
+	// Remaining is the number of changes from the same submission still waiting to be broadcast.
+	// A value of zero means the whole submission has been processed.
+	Remaining int
+}
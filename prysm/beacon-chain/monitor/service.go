@@ -229,6 +229,15 @@ func (s *Service) monitorRoutine(stateChannel chan *feed.Event, stateSub event.S
 				} else {
 					s.processUnaggregatedAttestation(s.ctx, data.Attestation)
 				}
+			case operation.UnaggregatedAttsReceived:
+				data, ok := e.Data.(*operation.UnAggregatedAttsReceivedData)
+				if !ok {
+					log.Error("Event feed data is not of type *operation.UnAggregatedAttsReceivedData")
+				} else {
+					for _, att := range data.Attestations {
+						s.processUnaggregatedAttestation(s.ctx, att)
+					}
+				}
 			case operation.AggregatedAttReceived:
 				data, ok := e.Data.(*operation.AggregatedAttReceivedData)
 				if !ok {
@@ -44,6 +44,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -951,6 +952,10 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := !b.cliCtx.Bool(flags.DisableDebugRPCEndpoints.Name)
+	attestationVerification, err := core.VerificationLevelFromString(b.cliCtx.String(flags.AttestationVerificationLevel.Name))
+	if err != nil {
+		return errors.Wrapf(err, "invalid value for --%s", flags.AttestationVerificationLevel.Name)
+	}
 
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
@@ -996,6 +1001,11 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		OperationNotifier:         b,
 		StateGen:                  b.stateGen,
 		EnableDebugRPCEndpoints:   enableDebugRPCEndpoints,
+		AttestationVerification:   attestationVerification,
+		MinAttBroadcastPeers:      b.cliCtx.Uint64(flags.MinAttestationBroadcastPeers.Name),
+		VerifyAttSource:           b.cliCtx.Bool(flags.VerifyAttestationSource.Name),
+		VerifyAttCommittee:        b.cliCtx.Bool(flags.VerifyAttestationCommittee.Name),
+		MaxListResponseSize:       b.cliCtx.Uint64(flags.MaxListResponseSize.Name),
 		MaxMsgSize:                maxMsgSize,
 		BlockBuilder:              b.fetchBuilderService(),
 		Router:                    router,
@@ -0,0 +1,39 @@
+package attestations
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// compactAttsPool compacts the aggregated attestations of the pool on every compaction interval.
+func (s *Service) compactAttsPool() {
+	ticker := time.NewTicker(s.cfg.compactInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.compactAggregatedAtts()
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// compactAggregatedAtts merges the aggregated attestations of every attestation data whose aggregation bits
+// do not overlap into fewer, larger aggregates.
+func (s *Service) compactAggregatedAtts() {
+	start := time.Now()
+	before, after, err := s.cfg.Pool.CompactAggregatedAttestations()
+	if err != nil {
+		log.WithError(err).Error("Could not compact aggregated attestations")
+		return
+	}
+	compactedAggregatedAtts.Add(float64(before - after))
+	log.WithFields(logrus.Fields{
+		"before":   before,
+		"after":    after,
+		"duration": time.Since(start),
+	}).Debug("Compacted aggregated attestations")
+}
@@ -3,6 +3,7 @@ package kv
 import (
 	"context"
 	"runtime"
+	"slices"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -14,6 +15,7 @@ import (
 	attaggregation "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 // AggregateUnaggregatedAttestations aggregates the unaggregated attestations and saves the
@@ -118,32 +120,33 @@ func (c *AttCaches) aggregateParallel(atts map[attestation.Id][]ethpb.Att, leftO
 }
 
 // SaveAggregatedAttestation saves an aggregated attestation in cache.
-func (c *AttCaches) SaveAggregatedAttestation(att ethpb.Att) error {
+// It returns false when the attestation is already contained in the cache or has already been seen.
+func (c *AttCaches) SaveAggregatedAttestation(att ethpb.Att) (bool, error) {
 	if err := helpers.ValidateNilAttestation(att); err != nil {
-		return err
+		return false, err
 	}
 	if !helpers.IsAggregated(att) {
-		return errors.New("attestation is not aggregated")
+		return false, errors.New("attestation is not aggregated")
 	}
 	has, err := c.HasAggregatedAttestation(att)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if has {
-		return nil
+		return false, nil
 	}
 
 	seen, err := c.hasSeenBit(att)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if seen {
-		return nil
+		return false, nil
 	}
 
 	id, err := attestation.NewId(att, attestation.Data)
 	if err != nil {
-		return errors.Wrap(err, "could not create attestation ID")
+		return false, errors.Wrap(err, "could not create attestation ID")
 	}
 	copiedAtt := att.Clone()
 
@@ -153,22 +156,22 @@ func (c *AttCaches) SaveAggregatedAttestation(att ethpb.Att) error {
 	if !ok {
 		atts := []ethpb.Att{copiedAtt}
 		c.aggregatedAtt[id] = atts
-		return nil
+		return true, nil
 	}
 
 	atts, err = attaggregation.Aggregate(append(atts, copiedAtt))
 	if err != nil {
-		return err
+		return false, err
 	}
 	c.aggregatedAtt[id] = atts
 
-	return nil
+	return true, nil
 }
 
 // SaveAggregatedAttestations saves a list of aggregated attestations in cache.
 func (c *AttCaches) SaveAggregatedAttestations(atts []ethpb.Att) error {
 	for _, att := range atts {
-		if err := c.SaveAggregatedAttestation(att); err != nil {
+		if _, err := c.SaveAggregatedAttestation(att); err != nil {
 			log.WithError(err).Debug("Could not save aggregated attestation")
 			if err := c.DeleteAggregatedAttestation(att); err != nil {
 				log.WithError(err).Debug("Could not delete aggregated attestation")
@@ -336,3 +339,49 @@ func (c *AttCaches) AggregatedAttestationCount() int {
 	defer c.aggregatedAttLock.RUnlock()
 	return len(c.aggregatedAtt)
 }
+
+// CompactAggregatedAttestations merges the aggregated attestations of every attestation data whose aggregation bits
+// do not overlap into fewer, larger aggregates, replacing the original attestations in the cache.
+// The attestations are aggregated without holding the lock, and the attestations of an attestation data are only
+// replaced when they did not change in the meantime.
+// It returns the number of aggregated attestations before and after the compaction.
+func (c *AttCaches) CompactAggregatedAttestations() (int, int, error) {
+	before := 0
+	// Saving an aggregated attestation aggregates the cached attestations in place,
+	// so the attestations to compact are cloned while holding the lock.
+	snapshot := make(map[attestation.Id][]ethpb.Att)
+	c.aggregatedAttLock.RLock()
+	for id, atts := range c.aggregatedAtt {
+		before += len(atts)
+		if len(atts) > 1 {
+			snapshot[id] = cloneAttestations(atts)
+		}
+	}
+	c.aggregatedAttLock.RUnlock()
+
+	after := before
+	for id, atts := range snapshot {
+		// Aggregation happens in place, so the snapshot is kept intact to detect concurrent changes.
+		aggregated, err := attaggregation.Aggregate(cloneAttestations(atts))
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "could not aggregate attestations")
+		}
+
+		c.aggregatedAttLock.Lock()
+		current := c.aggregatedAtt[id]
+		if slices.EqualFunc(current, atts, func(a, b ethpb.Att) bool { return proto.Equal(a, b) }) {
+			c.aggregatedAtt[id] = aggregated
+			after -= len(atts) - len(aggregated)
+		}
+		c.aggregatedAttLock.Unlock()
+	}
+	return before, after, nil
+}
+
+func cloneAttestations(atts []ethpb.Att) []ethpb.Att {
+	cloned := make([]ethpb.Att, len(atts))
+	for i, a := range atts {
+		cloned[i] = a.Clone()
+	}
+	return cloned
+}
@@ -102,7 +102,7 @@ func TestKV_Aggregated_SaveAggregatedAttestation(t *testing.T) {
 			cache.seenAtt.Set(id.String(), []bitfield.Bitlist{{0xff}}, c.DefaultExpiration)
 			assert.Equal(t, 0, len(cache.unAggregatedAtt), "Invalid start pool, atts: %d", len(cache.unAggregatedAtt))
 
-			err := cache.SaveAggregatedAttestation(tt.att)
+			_, err := cache.SaveAggregatedAttestation(tt.att)
 			if tt.wantErrString != "" {
 				assert.ErrorContains(t, tt.wantErrString, err)
 			} else {
@@ -114,6 +114,19 @@ func TestKV_Aggregated_SaveAggregatedAttestation(t *testing.T) {
 	}
 }
 
+func TestKV_Aggregated_SaveAggregatedAttestation_Duplicate(t *testing.T) {
+	cache := NewAttCaches()
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}})
+
+	added, err := cache.SaveAggregatedAttestation(att)
+	require.NoError(t, err)
+	assert.Equal(t, true, added)
+	added, err = cache.SaveAggregatedAttestation(att)
+	require.NoError(t, err)
+	assert.Equal(t, false, added)
+	assert.Equal(t, 1, cache.AggregatedAttestationCount())
+}
+
 func TestKV_Aggregated_SaveAggregatedAttestations(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -193,7 +206,8 @@ func TestKV_Aggregated_AggregatedAttestations(t *testing.T) {
 	atts := []ethpb.Att{att1, att2, att3}
 
 	for _, att := range atts {
-		require.NoError(t, cache.SaveAggregatedAttestation(att))
+		_, err := cache.SaveAggregatedAttestation(att)
+		require.NoError(t, err)
 	}
 
 	returned := cache.AggregatedAttestations()
@@ -490,7 +504,8 @@ func TestKV_Aggregated_DuplicateAggregatedAttestations(t *testing.T) {
 	atts := []*ethpb.Attestation{att1, att2}
 
 	for _, att := range atts {
-		require.NoError(t, cache.SaveAggregatedAttestation(att))
+		_, err := cache.SaveAggregatedAttestation(att)
+		require.NoError(t, err)
 	}
 
 	returned := cache.AggregatedAttestations()
@@ -500,6 +515,46 @@ func TestKV_Aggregated_DuplicateAggregatedAttestations(t *testing.T) {
 	assert.Equal(t, 1, len(returned), "Did not receive correct aggregated atts")
 }
 
+func TestKV_Aggregated_CompactAggregatedAttestations(t *testing.T) {
+	cache := NewAttCaches()
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte{'a'}).Marshal()
+	att1 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1000111}, Signature: sig})
+	att2 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1011000}, Signature: sig})
+	att3 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1100100}, Signature: sig})
+	att4 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b10011}, Signature: sig})
+
+	// Bypass the aggregation done when saving, so that the cache holds mergeable attestations.
+	id1, err := attestation.NewId(att1, attestation.Data)
+	require.NoError(t, err)
+	id4, err := attestation.NewId(att4, attestation.Data)
+	require.NoError(t, err)
+	cache.aggregatedAtt[id1] = []ethpb.Att{att1, att2, att3}
+	cache.aggregatedAtt[id4] = []ethpb.Att{att4}
+
+	before, after, err := cache.CompactAggregatedAttestations()
+	require.NoError(t, err)
+	assert.Equal(t, 4, before)
+	assert.Equal(t, 3, after)
+	// att1 and att2 are merged, while att3 overlaps with both of them.
+	require.Equal(t, 2, len(cache.aggregatedAtt[id1]))
+	bits := make([]bitfield.Bitlist, 0, 2)
+	for _, a := range cache.aggregatedAtt[id1] {
+		bits = append(bits, a.GetAggregationBits())
+	}
+	sort.Slice(bits, func(i, j int) bool {
+		return bits[i].Count() > bits[j].Count()
+	})
+	assert.DeepEqual(t, []bitfield.Bitlist{{0b1011111}, {0b1100100}}, bits)
+	assert.Equal(t, 1, len(cache.aggregatedAtt[id4]))
+
+	before, after, err = cache.CompactAggregatedAttestations()
+	require.NoError(t, err)
+	assert.Equal(t, 3, before)
+	assert.Equal(t, 3, after)
+}
+
 func TestKV_Aggregated_AggregatedAttestationsBySlotIndex(t *testing.T) {
 	cache := NewAttCaches()
 
@@ -509,7 +564,8 @@ func TestKV_Aggregated_AggregatedAttestationsBySlotIndex(t *testing.T) {
 	atts := []*ethpb.Attestation{att1, att2, att3}
 
 	for _, att := range atts {
-		require.NoError(t, cache.SaveAggregatedAttestation(att))
+		_, err := cache.SaveAggregatedAttestation(att)
+		require.NoError(t, err)
 	}
 	ctx := context.Background()
 	returned := cache.AggregatedAttestationsBySlotIndex(ctx, 1, 1)
@@ -535,7 +591,8 @@ func TestKV_Aggregated_AggregatedAttestationsBySlotIndexElectra(t *testing.T) {
 	atts := []*ethpb.AttestationElectra{att1, att2, att3}
 
 	for _, att := range atts {
-		require.NoError(t, cache.SaveAggregatedAttestation(att))
+		_, err := cache.SaveAggregatedAttestation(att)
+		require.NoError(t, err)
 	}
 	ctx := context.Background()
 	returned := cache.AggregatedAttestationsBySlotIndexElectra(ctx, 1, 1)
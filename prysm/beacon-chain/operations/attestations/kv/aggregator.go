@@ -0,0 +1,85 @@
+package kv
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// aggregatorAttestation is an aggregate recorded for an aggregator together with the time it was received.
+// The time is kept per aggregate because every save refreshes the expiration of the aggregator's cache entry.
+type aggregatorAttestation struct {
+	att      ethpb.Att
+	received time.Time
+}
+
+// aggregatorAttRetention is the duration for which aggregates of an aggregator are retained.
+func aggregatorAttRetention() time.Duration {
+	return time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(2*params.BeaconConfig().SecondsPerSlot)) * time.Second
+}
+
+// SaveAggregatorAttestation records an aggregate received through the aggregate-and-proof path
+// together with the index of the validator that produced it.
+func (c *AttCaches) SaveAggregatorAttestation(aggregatorIndex primitives.ValidatorIndex, att ethpb.Att) error {
+	if err := helpers.ValidateNilAttestation(att); err != nil {
+		return err
+	}
+
+	key := strconv.FormatUint(uint64(aggregatorIndex), 10)
+	now := time.Now()
+
+	c.aggregatorAttLock.Lock()
+	defer c.aggregatorAttLock.Unlock()
+	var atts []aggregatorAttestation
+	if v, ok := c.aggregatorAtt.Get(key); ok {
+		atts, ok = v.([]aggregatorAttestation)
+		if !ok {
+			return errors.New("could not convert to aggregator attestations type")
+		}
+	}
+	atts = append(recentAggregatorAttestations(atts, now), aggregatorAttestation{att: att.Clone(), received: now})
+	c.aggregatorAtt.Set(key, atts, cache.DefaultExpiration /* two epochs */)
+	return nil
+}
+
+// AggregatorAttestations returns the aggregates produced by the given aggregator
+// that were received within the last two epochs.
+func (c *AttCaches) AggregatorAttestations(aggregatorIndex primitives.ValidatorIndex) []ethpb.Att {
+	c.aggregatorAttLock.RLock()
+	defer c.aggregatorAttLock.RUnlock()
+
+	v, ok := c.aggregatorAtt.Get(strconv.FormatUint(uint64(aggregatorIndex), 10))
+	if !ok {
+		return []ethpb.Att{}
+	}
+	atts, ok := v.([]aggregatorAttestation)
+	if !ok {
+		return []ethpb.Att{}
+	}
+	cutoff := time.Now().Add(-aggregatorAttRetention())
+	result := make([]ethpb.Att, 0, len(atts))
+	for _, a := range atts {
+		if a.received.After(cutoff) {
+			result = append(result, a.att)
+		}
+	}
+	return result
+}
+
+// recentAggregatorAttestations returns a copy of the aggregates that are still within the retention period.
+func recentAggregatorAttestations(atts []aggregatorAttestation, now time.Time) []aggregatorAttestation {
+	cutoff := now.Add(-aggregatorAttRetention())
+	recent := make([]aggregatorAttestation, 0, len(atts)+1)
+	for _, a := range atts {
+		if a.received.After(cutoff) {
+			recent = append(recent, a)
+		}
+	}
+	return recent
+}
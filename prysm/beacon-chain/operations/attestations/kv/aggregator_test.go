@@ -0,0 +1,55 @@
+package kv
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestAttCaches_AggregatorAttestations(t *testing.T) {
+	c := NewAttCaches()
+
+	att1 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}})
+	att2 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1110}})
+	att3 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 3}, AggregationBits: bitfield.Bitlist{0b1011}})
+	require.NoError(t, c.SaveAggregatorAttestation(1, att1))
+	require.NoError(t, c.SaveAggregatorAttestation(1, att2))
+	require.NoError(t, c.SaveAggregatorAttestation(2, att3))
+
+	atts := c.AggregatorAttestations(1)
+	require.Equal(t, 2, len(atts))
+	assert.DeepEqual(t, att1, atts[0])
+	assert.DeepEqual(t, att2, atts[1])
+	atts = c.AggregatorAttestations(2)
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, att3, atts[0])
+	assert.Equal(t, 0, len(c.AggregatorAttestations(3)))
+
+	require.ErrorContains(t, "nil", c.SaveAggregatorAttestation(1, &ethpb.Attestation{}))
+}
+
+func TestAttCaches_AggregatorAttestations_Expired(t *testing.T) {
+	c := NewAttCaches()
+
+	old := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b1101}})
+	recent := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b1110}})
+	// Saves keep the cache entry of an active aggregator alive, so an aggregate older than the retention can remain in it.
+	c.aggregatorAtt.Set(strconv.Itoa(1), []aggregatorAttestation{
+		{att: old, received: time.Now().Add(-aggregatorAttRetention() - time.Second)},
+	}, 0)
+	assert.Equal(t, 0, len(c.AggregatorAttestations(1)))
+
+	require.NoError(t, c.SaveAggregatorAttestation(1, recent))
+	atts := c.AggregatorAttestations(1)
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, recent, atts[0])
+	v, ok := c.aggregatorAtt.Get(strconv.Itoa(1))
+	require.Equal(t, true, ok)
+	assert.Equal(t, 1, len(v.([]aggregatorAttestation)), "Expired aggregate was not pruned on save")
+}
@@ -26,6 +26,10 @@ type AttCaches struct {
 	blockAttLock       sync.RWMutex
 	blockAtt           map[attestation.Id][]ethpb.Att
 	seenAtt            *cache.Cache
+	aggregatorAttLock  sync.RWMutex
+	aggregatorAtt      *cache.Cache
+	attSourceLock      sync.Mutex
+	attSource          *cache.Cache
 }
 
 // NewAttCaches initializes a new attestation pool consists of multiple KV store in cache for
@@ -39,6 +43,8 @@ func NewAttCaches() *AttCaches {
 		forkchoiceAtt:   make(map[attestation.Id]ethpb.Att),
 		blockAtt:        make(map[attestation.Id][]ethpb.Att),
 		seenAtt:         c,
+		aggregatorAtt:   cache.New(2*secsInEpoch*time.Second, 2*secsInEpoch*time.Second),
+		attSource:       cache.New(2*secsInEpoch*time.Second, 2*secsInEpoch*time.Second),
 	}
 
 	return pool
@@ -0,0 +1,69 @@
+package kv
+
+import (
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+)
+
+// Sources through which attestations enter the pool.
+const (
+	// AttestationSourceGossip is reported for attestations that were not submitted through the beacon API,
+	// which are received on the gossip network.
+	AttestationSourceGossip = "gossip"
+	// AttestationSourceRPC is reported for attestations that include an attestation submitted through the beacon API.
+	AttestationSourceRPC = "rpc"
+)
+
+// SaveRPCAttestationSource records that the attestation was submitted through the beacon API.
+// The aggregation bits are recorded by attestation data, so that aggregates the attestation is merged into
+// are attributed to the API as well. Records are retained for two epochs after the last submission for the data.
+func (c *AttCaches) SaveRPCAttestationSource(att ethpb.Att) error {
+	if err := helpers.ValidateNilAttestation(att); err != nil {
+		return err
+	}
+	id, err := attestation.NewId(att, attestation.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not create attestation ID")
+	}
+	key := string(id[:])
+
+	c.attSourceLock.Lock()
+	defer c.attSourceLock.Unlock()
+	var bits []bitfield.Bitlist
+	if v, ok := c.attSource.Get(key); ok {
+		bits, _ = v.([]bitfield.Bitlist)
+	}
+	c.attSource.Set(key, append(bits, att.GetAggregationBits()), cache.DefaultExpiration /* two epochs */)
+	return nil
+}
+
+// AttestationSource returns the source through which the attestation entered the pool. An attestation is attributed
+// to the beacon API when its aggregation bits include the ones of an attestation with the same data that was
+// submitted through the API, and to the gossip network otherwise.
+func (c *AttCaches) AttestationSource(att ethpb.Att) string {
+	if helpers.ValidateNilAttestation(att) != nil {
+		return AttestationSourceGossip
+	}
+	id, err := attestation.NewId(att, attestation.Data)
+	if err != nil {
+		return AttestationSourceGossip
+	}
+
+	c.attSourceLock.Lock()
+	defer c.attSourceLock.Unlock()
+	v, ok := c.attSource.Get(string(id[:]))
+	if !ok {
+		return AttestationSourceGossip
+	}
+	bits, _ := v.([]bitfield.Bitlist)
+	for _, b := range bits {
+		if contained, err := att.GetAggregationBits().Contains(b); err == nil && contained {
+			return AttestationSourceRPC
+		}
+	}
+	return AttestationSourceGossip
+}
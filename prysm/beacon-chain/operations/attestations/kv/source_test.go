@@ -0,0 +1,30 @@
+package kv
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestAttCaches_AttestationSource(t *testing.T) {
+	c := NewAttCaches()
+
+	att1 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b10001}})
+	att2 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b10010}})
+	att3 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b10001}})
+	// An aggregate of att1 with another attestation.
+	merged := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b10101}})
+	require.NoError(t, c.SaveRPCAttestationSource(att1))
+
+	assert.Equal(t, AttestationSourceRPC, c.AttestationSource(att1))
+	assert.Equal(t, AttestationSourceRPC, c.AttestationSource(merged))
+	// Attestations without a record were received on gossip.
+	assert.Equal(t, AttestationSourceGossip, c.AttestationSource(att2))
+	assert.Equal(t, AttestationSourceGossip, c.AttestationSource(att3))
+
+	require.ErrorContains(t, "nil", c.SaveRPCAttestationSource(&ethpb.Attestation{}))
+}
@@ -13,38 +13,40 @@ import (
 )
 
 // SaveUnaggregatedAttestation saves an unaggregated attestation in cache.
-func (c *AttCaches) SaveUnaggregatedAttestation(att ethpb.Att) error {
+// It returns false when the attestation was already present in the cache or has already been seen.
+func (c *AttCaches) SaveUnaggregatedAttestation(att ethpb.Att) (bool, error) {
 	if att == nil {
-		return nil
+		return false, nil
 	}
 	if helpers.IsAggregated(att) {
-		return errors.New("attestation is aggregated")
+		return false, errors.New("attestation is aggregated")
 	}
 
 	seen, err := c.hasSeenBit(att)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if seen {
-		return nil
+		return false, nil
 	}
 
 	id, err := attestation.NewId(att, attestation.Full)
 	if err != nil {
-		return errors.Wrap(err, "could not create attestation ID")
+		return false, errors.Wrap(err, "could not create attestation ID")
 	}
 
 	c.unAggregateAttLock.Lock()
 	defer c.unAggregateAttLock.Unlock()
+	_, exists := c.unAggregatedAtt[id]
 	c.unAggregatedAtt[id] = att
 
-	return nil
+	return !exists, nil
 }
 
 // SaveUnaggregatedAttestations saves a list of unaggregated attestations in cache.
 func (c *AttCaches) SaveUnaggregatedAttestations(atts []ethpb.Att) error {
 	for _, att := range atts {
-		if err := c.SaveUnaggregatedAttestation(att); err != nil {
+		if _, err := c.SaveUnaggregatedAttestation(att); err != nil {
 			return err
 		}
 	}
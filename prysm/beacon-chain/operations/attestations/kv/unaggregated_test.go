@@ -71,7 +71,7 @@ func TestKV_Unaggregated_SaveUnaggregatedAttestation(t *testing.T) {
 				tt.att.(*ethpb.Attestation).Signature = make([]byte, fieldparams.BLSSignatureLength)
 			}
 
-			err := cache.SaveUnaggregatedAttestation(tt.att)
+			_, err := cache.SaveUnaggregatedAttestation(tt.att)
 			if tt.wantErrString != "" {
 				assert.ErrorContains(t, tt.wantErrString, err)
 			} else {
@@ -83,6 +83,19 @@ func TestKV_Unaggregated_SaveUnaggregatedAttestation(t *testing.T) {
 	}
 }
 
+func TestKV_Unaggregated_SaveUnaggregatedAttestation_Duplicate(t *testing.T) {
+	cache := NewAttCaches()
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b0001}})
+
+	added, err := cache.SaveUnaggregatedAttestation(att)
+	require.NoError(t, err)
+	assert.Equal(t, true, added)
+	added, err = cache.SaveUnaggregatedAttestation(att)
+	require.NoError(t, err)
+	assert.Equal(t, false, added)
+	assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+}
+
 func TestKV_Unaggregated_SaveUnaggregatedAttestations(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -243,7 +256,8 @@ func TestKV_Unaggregated_UnaggregatedAttestationsBySlotIndex(t *testing.T) {
 	atts := []*ethpb.Attestation{att1, att2, att3}
 
 	for _, att := range atts {
-		require.NoError(t, cache.SaveUnaggregatedAttestation(att))
+		_, err := cache.SaveUnaggregatedAttestation(att)
+		require.NoError(t, err)
 	}
 	ctx := context.Background()
 	returned := cache.UnaggregatedAttestationsBySlotIndex(ctx, 1, 1)
@@ -269,7 +283,8 @@ func TestKV_Unaggregated_UnaggregatedAttestationsBySlotIndexElectra(t *testing.T
 	atts := []*ethpb.AttestationElectra{att1, att2, att3}
 
 	for _, att := range atts {
-		require.NoError(t, cache.SaveUnaggregatedAttestation(att))
+		_, err := cache.SaveUnaggregatedAttestation(att)
+		require.NoError(t, err)
 	}
 	ctx := context.Background()
 	returned := cache.UnaggregatedAttestationsBySlotIndexElectra(ctx, 1, 1)
@@ -30,6 +30,10 @@ var (
 		Name: "expired_block_atts_total",
 		Help: "The number of expired and deleted block attestations in the pool.",
 	})
+	compactedAggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "compacted_aggregated_atts_total",
+		Help: "The number of aggregated attestations in the pool merged into other aggregated attestations.",
+	})
 	batchForkChoiceAttsT1 = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "aggregate_attestations_t1",
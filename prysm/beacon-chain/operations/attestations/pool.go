@@ -15,7 +15,7 @@ import (
 type Pool interface {
 	// For Aggregated attestations
 	AggregateUnaggregatedAttestations(ctx context.Context) error
-	SaveAggregatedAttestation(att ethpb.Att) error
+	SaveAggregatedAttestation(att ethpb.Att) (bool, error)
 	SaveAggregatedAttestations(atts []ethpb.Att) error
 	AggregatedAttestations() []ethpb.Att
 	AggregatedAttestationsBySlotIndex(ctx context.Context, slot primitives.Slot, committeeIndex primitives.CommitteeIndex) []*ethpb.Attestation
@@ -23,8 +23,15 @@ type Pool interface {
 	DeleteAggregatedAttestation(att ethpb.Att) error
 	HasAggregatedAttestation(att ethpb.Att) (bool, error)
 	AggregatedAttestationCount() int
+	CompactAggregatedAttestations() (int, int, error)
+	// For aggregates received through the aggregate-and-proof path, keyed by aggregator.
+	SaveAggregatorAttestation(aggregatorIndex primitives.ValidatorIndex, att ethpb.Att) error
+	AggregatorAttestations(aggregatorIndex primitives.ValidatorIndex) []ethpb.Att
+	// For the sources through which attestations entered the pool.
+	SaveRPCAttestationSource(att ethpb.Att) error
+	AttestationSource(att ethpb.Att) string
 	// For unaggregated attestations.
-	SaveUnaggregatedAttestation(att ethpb.Att) error
+	SaveUnaggregatedAttestation(att ethpb.Att) (bool, error)
 	SaveUnaggregatedAttestations(atts []ethpb.Att) error
 	UnaggregatedAttestations() ([]ethpb.Att, error)
 	UnaggregatedAttestationsBySlotIndex(ctx context.Context, slot primitives.Slot, committeeIndex primitives.CommitteeIndex) []*ethpb.Attestation
@@ -68,19 +68,26 @@ func (s *Service) pruneExpiredAtts() {
 // Return true if the input slot has been expired.
 // Expired is defined as one epoch behind than current time.
 func (s *Service) expired(providedSlot primitives.Slot) bool {
+	return Expired(s.genesisTime, providedSlot)
+}
+
+// Expired reports whether attestations for the provided slot are due to be pruned from the pool,
+// given the genesis time in seconds.
+// Expired is defined as one epoch behind than current time.
+func Expired(genesisTime uint64, providedSlot primitives.Slot) bool {
 	providedEpoch := slots.ToEpoch(providedSlot)
-	currSlot := slots.CurrentSlot(s.genesisTime)
+	currSlot := slots.CurrentSlot(genesisTime)
 	currEpoch := slots.ToEpoch(currSlot)
 	if currEpoch < params.BeaconConfig().DenebForkEpoch {
-		return s.expiredPreDeneb(providedSlot)
+		return expiredPreDeneb(genesisTime, providedSlot)
 	}
 	return providedEpoch+1 < currEpoch
 }
 
 // Handles expiration of attestations before deneb.
-func (s *Service) expiredPreDeneb(slot primitives.Slot) bool {
+func expiredPreDeneb(genesisTime uint64, slot primitives.Slot) bool {
 	expirationSlot := slot + params.BeaconConfig().SlotsPerEpoch
-	expirationTime := s.genesisTime + uint64(expirationSlot.Mul(params.BeaconConfig().SecondsPerSlot))
+	expirationTime := genesisTime + uint64(expirationSlot.Mul(params.BeaconConfig().SecondsPerSlot))
 	currentTime := uint64(prysmTime.Now().Unix())
 	return currentTime >= expirationTime
 }
@@ -29,6 +29,7 @@ type Service struct {
 type Config struct {
 	Pool                Pool
 	pruneInterval       time.Duration
+	compactInterval     time.Duration
 	InitialSyncComplete chan struct{}
 }
 
@@ -41,6 +42,10 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		// Prune expired attestations from the pool every slot interval.
 		cfg.pruneInterval = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
 	}
+	if cfg.compactInterval == 0 {
+		// Compact the aggregated attestations of the pool every epoch.
+		cfg.compactInterval = time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
@@ -59,6 +64,7 @@ func (s *Service) Start() {
 	}
 	go s.prepareForkChoiceAtts()
 	go s.pruneAttsPool()
+	go s.compactAttsPool()
 }
 
 // waitForSync waits until the beacon node is synced to the latest head.
@@ -16,6 +16,11 @@ func (m *PoolMock) PendingBLSToExecChanges() ([]*eth.SignedBLSToExecutionChange,
 	return m.Changes, nil
 }
 
+// PendingBLSToExecChangeCount --
+func (m *PoolMock) PendingBLSToExecChangeCount() int {
+	return len(m.Changes)
+}
+
 // BLSToExecChangesForInclusion --
 func (m *PoolMock) BLSToExecChangesForInclusion(_ state.ReadOnlyBeaconState) ([]*eth.SignedBLSToExecutionChange, error) {
 	return m.Changes, nil
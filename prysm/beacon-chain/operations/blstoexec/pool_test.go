@@ -42,6 +42,16 @@ func TestPendingBLSToExecChanges(t *testing.T) {
 	})
 }
 
+func TestPendingBLSToExecChangeCount(t *testing.T) {
+	pool := NewPool()
+	assert.Equal(t, 0, pool.PendingBLSToExecChangeCount())
+	pool.InsertBLSToExecChange(&eth.SignedBLSToExecutionChange{Message: &eth.BLSToExecutionChange{ValidatorIndex: 0}})
+	pool.InsertBLSToExecChange(&eth.SignedBLSToExecutionChange{Message: &eth.BLSToExecutionChange{ValidatorIndex: 1}})
+	assert.Equal(t, 2, pool.PendingBLSToExecChangeCount())
+	pool.MarkIncluded(&eth.SignedBLSToExecutionChange{Message: &eth.BLSToExecutionChange{ValidatorIndex: 0}})
+	assert.Equal(t, 1, pool.PendingBLSToExecChangeCount())
+}
+
 func TestBLSToExecChangesForInclusion(t *testing.T) {
 	spb := &eth.BeaconStateCapella{
 		Fork: &eth.Fork{
@@ -44,3 +44,13 @@ func (*PoolMock) MarkIncludedAttesterSlashing(_ ethpb.AttSlashing) {
 func (*PoolMock) MarkIncludedProposerSlashing(_ *ethpb.ProposerSlashing) {
 	panic("implement me")
 }
+
+// PruneSlashedValidators --
+func (*PoolMock) PruneSlashedValidators(_ state.ReadOnlyBeaconState) (int, int) {
+	panic("implement me")
+}
+
+// PendingSlashingCounts --
+func (m *PoolMock) PendingSlashingCounts() (int, int) {
+	return len(m.PendingAttSlashings), len(m.PendingPropSlashings)
+}
@@ -264,6 +264,66 @@ func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
 	numProposerSlashingsIncluded.Inc()
 }
 
+// PruneSlashedValidators removes pending slashings of validators that are already slashed in the given state.
+// It returns the number of attester and proposer slashings that were removed from the pool entirely.
+// An attester slashing is only removed once all validators it slashes are slashed.
+func (p *Pool) PruneSlashedValidators(state state.ReadOnlyBeaconState) (attester int, proposer int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	slashed := func(idx primitives.ValidatorIndex) bool {
+		val, err := state.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			log.WithError(err).Debug("Could not get validator of pending slashing")
+			return false
+		}
+		return val.Slashed()
+	}
+
+	removedAttSlashings := make(map[ethpb.AttSlashing]bool)
+	remainingAtt := p.pendingAttesterSlashing[:0]
+	for _, slashing := range p.pendingAttesterSlashing {
+		if slashed(slashing.validatorToSlash) {
+			removedAttSlashings[slashing.attesterSlashing] = true
+			continue
+		}
+		remainingAtt = append(remainingAtt, slashing)
+	}
+	p.pendingAttesterSlashing = remainingAtt
+	// Slashings of several validators are still actionable while any of their validators remains pending.
+	for _, slashing := range p.pendingAttesterSlashing {
+		delete(removedAttSlashings, slashing.attesterSlashing)
+	}
+
+	remainingProp := p.pendingProposerSlashing[:0]
+	for _, slashing := range p.pendingProposerSlashing {
+		if slashed(slashing.Header_1.Header.ProposerIndex) {
+			proposer++
+			continue
+		}
+		remainingProp = append(remainingProp, slashing)
+	}
+	p.pendingProposerSlashing = remainingProp
+
+	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashing)))
+	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashing)))
+	return len(removedAttSlashings), proposer
+}
+
+// PendingSlashingCounts returns the number of attester and proposer slashings in the pool.
+// Unlike PendingAttesterSlashings and PendingProposerSlashings, it does not check the slashings against any state.
+func (p *Pool) PendingSlashingCounts() (attester int, proposer int) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	// An attester slashing is pooled once for every validator it slashes.
+	attSlashings := make(map[ethpb.AttSlashing]bool, len(p.pendingAttesterSlashing))
+	for _, slashing := range p.pendingAttesterSlashing {
+		attSlashings[slashing.attesterSlashing] = true
+	}
+	return len(attSlashings), len(p.pendingProposerSlashing)
+}
+
 // this function checks a few items about a validator before proceeding with inserting
 // a proposer/attester slashing into the pool. First, it checks if the validator
 // has been recently included in the pool, then it checks if the validator is slashable.
@@ -4,7 +4,11 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
 var (
@@ -19,3 +23,59 @@ func TestPool_validatorSlashingPreconditionCheck_requiresLock(t *testing.T) {
 	_, err := p.validatorSlashingPreconditionCheck(nil, 0)
 	require.ErrorContains(t, "caller must hold read/write lock", err)
 }
+
+func TestPool_PruneSlashedValidators(t *testing.T) {
+	beaconState, _ := util.DeterministicGenesisState(t, 8)
+	for _, idx := range []primitives.ValidatorIndex{1, 2, 3, 5} {
+		val, err := beaconState.ValidatorAtIndex(idx)
+		require.NoError(t, err)
+		val.Slashed = true
+		require.NoError(t, beaconState.UpdateValidatorAtIndex(idx, val))
+	}
+
+	// Validators 1 and 2 are both slashed, while validator 4 of the second slashing is not.
+	fullySlashed := attesterSlashingForValIdx(1, 2)
+	partiallySlashed := attesterSlashingForValIdx(3, 4)
+	p := &Pool{
+		pendingAttesterSlashing: []*PendingAttesterSlashing{
+			{attesterSlashing: fullySlashed, validatorToSlash: 1},
+			{attesterSlashing: fullySlashed, validatorToSlash: 2},
+			{attesterSlashing: partiallySlashed, validatorToSlash: 3},
+			{attesterSlashing: partiallySlashed, validatorToSlash: 4},
+		},
+		pendingProposerSlashing: []*ethpb.ProposerSlashing{
+			proposerSlashingForValIdx(5),
+			proposerSlashingForValIdx(6),
+		},
+	}
+
+	attester, proposer := p.PruneSlashedValidators(beaconState)
+	assert.Equal(t, 1, attester)
+	assert.Equal(t, 1, proposer)
+	require.Equal(t, 1, len(p.pendingAttesterSlashing))
+	assert.Equal(t, primitives.ValidatorIndex(4), p.pendingAttesterSlashing[0].validatorToSlash)
+	require.Equal(t, 1, len(p.pendingProposerSlashing))
+	assert.Equal(t, primitives.ValidatorIndex(6), p.pendingProposerSlashing[0].Header_1.Header.ProposerIndex)
+}
+
+func TestPool_PendingSlashingCounts(t *testing.T) {
+	attester, proposer := NewPool().PendingSlashingCounts()
+	assert.Equal(t, 0, attester)
+	assert.Equal(t, 0, proposer)
+
+	// The slashing of validators 1 and 2 is pooled once per validator, but counted once.
+	doubleSlashing := attesterSlashingForValIdx(1, 2)
+	p := &Pool{
+		pendingAttesterSlashing: []*PendingAttesterSlashing{
+			{attesterSlashing: doubleSlashing, validatorToSlash: 1},
+			{attesterSlashing: doubleSlashing, validatorToSlash: 2},
+			pendingSlashingForValIdx(3),
+		},
+		pendingProposerSlashing: []*ethpb.ProposerSlashing{
+			proposerSlashingForValIdx(5),
+		},
+	}
+	attester, proposer = p.PendingSlashingCounts()
+	assert.Equal(t, 2, attester)
+	assert.Equal(t, 1, proposer)
+}
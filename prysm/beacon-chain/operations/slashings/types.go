@@ -31,6 +31,8 @@ type PoolManager interface {
 	PendingProposerSlashings(ctx context.Context, state state.ReadOnlyBeaconState, noLimit bool) []*ethpb.ProposerSlashing
 	MarkIncludedAttesterSlashing(as ethpb.AttSlashing)
 	MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing)
+	PruneSlashedValidators(state state.ReadOnlyBeaconState) (attester int, proposer int)
+	PendingSlashingCounts() (attester int, proposer int)
 }
 
 // Pool is a concrete implementation of PoolManager.
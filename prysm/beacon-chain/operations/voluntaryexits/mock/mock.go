@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -16,6 +17,11 @@ func (m *PoolMock) PendingExits() ([]*eth.SignedVoluntaryExit, error) {
 	return m.Exits, nil
 }
 
+// PendingExitCount --
+func (m *PoolMock) PendingExitCount() int {
+	return len(m.Exits)
+}
+
 // ExitsForInclusion --
 func (m *PoolMock) ExitsForInclusion(_ state.ReadOnlyBeaconState, _ primitives.Slot) ([]*eth.SignedVoluntaryExit, error) {
 	return m.Exits, nil
@@ -26,7 +32,33 @@ func (m *PoolMock) InsertVoluntaryExit(exit *eth.SignedVoluntaryExit) {
 	m.Exits = append(m.Exits, exit)
 }
 
+// StageVoluntaryExit --
+func (m *PoolMock) StageVoluntaryExit(exit *eth.SignedVoluntaryExit) {
+	m.Exits = append(m.Exits, exit)
+}
+
 // MarkIncluded --
 func (*PoolMock) MarkIncluded(_ *eth.SignedVoluntaryExit) {
 	panic("implement me")
 }
+
+// RemoveByValidatorIndex --
+func (m *PoolMock) RemoveByValidatorIndex(validatorIndex primitives.ValidatorIndex, expectedRoot *[32]byte) (*eth.SignedVoluntaryExit, error) {
+	for i, exit := range m.Exits {
+		if exit.Exit.ValidatorIndex != validatorIndex {
+			continue
+		}
+		if expectedRoot != nil {
+			root, err := exit.HashTreeRoot()
+			if err != nil {
+				return nil, err
+			}
+			if root != *expectedRoot {
+				return nil, voluntaryexits.ErrExitRootMismatch
+			}
+		}
+		m.Exits = append(m.Exits[:i], m.Exits[i+1:]...)
+		return exit, nil
+	}
+	return nil, voluntaryexits.ErrExitNotFound
+}
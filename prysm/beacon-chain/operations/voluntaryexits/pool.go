@@ -4,6 +4,7 @@ import (
 	"math"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -18,16 +19,27 @@ import (
 // This pool is used by proposers to insert voluntary exits into new blocks.
 type PoolManager interface {
 	PendingExits() ([]*ethpb.SignedVoluntaryExit, error)
+	PendingExitCount() int
 	ExitsForInclusion(state state.ReadOnlyBeaconState, slot types.Slot) ([]*ethpb.SignedVoluntaryExit, error)
 	InsertVoluntaryExit(exit *ethpb.SignedVoluntaryExit)
+	StageVoluntaryExit(exit *ethpb.SignedVoluntaryExit)
 	MarkIncluded(exit *ethpb.SignedVoluntaryExit)
+	RemoveByValidatorIndex(validatorIndex types.ValidatorIndex, expectedRoot *[32]byte) (*ethpb.SignedVoluntaryExit, error)
 }
 
+var (
+	// ErrExitNotFound is returned when the pool holds no exit for the validator.
+	ErrExitNotFound = errors.New("no pending exit for validator")
+	// ErrExitRootMismatch is returned when the pooled exit of the validator does not have the expected root.
+	ErrExitRootMismatch = errors.New("pending exit does not match the expected root")
+)
+
 // Pool is a concrete implementation of PoolManager.
 type Pool struct {
 	lock    sync.RWMutex
 	pending doublylinkedlist.List[*ethpb.SignedVoluntaryExit]
 	m       map[types.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedVoluntaryExit]
+	staged  map[types.ValidatorIndex]bool
 }
 
 // NewPool returns an initialized pool.
@@ -35,6 +47,7 @@ func NewPool() *Pool {
 	return &Pool{
 		pending: doublylinkedlist.List[*ethpb.SignedVoluntaryExit]{},
 		m:       make(map[types.ValidatorIndex]*doublylinkedlist.Node[*ethpb.SignedVoluntaryExit]),
+		staged:  make(map[types.ValidatorIndex]bool),
 	}
 }
 
@@ -59,8 +72,15 @@ func (p *Pool) PendingExits() ([]*ethpb.SignedVoluntaryExit, error) {
 	return result, nil
 }
 
+// PendingExitCount returns the number of objects in the pool.
+func (p *Pool) PendingExitCount() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.pending.Len()
+}
+
 // ExitsForInclusion returns objects that are ready for inclusion at the given slot. This method will not
-// return more than the block enforced MaxVoluntaryExits.
+// return more than the block enforced MaxVoluntaryExits. Staged exits are not returned.
 func (p *Pool) ExitsForInclusion(state state.ReadOnlyBeaconState, slot types.Slot) ([]*ethpb.SignedVoluntaryExit, error) {
 	p.lock.RLock()
 	length := int(math.Min(float64(params.BeaconConfig().MaxVoluntaryExits), float64(p.pending.Len())))
@@ -72,7 +92,7 @@ func (p *Pool) ExitsForInclusion(state state.ReadOnlyBeaconState, slot types.Slo
 			p.lock.RUnlock()
 			return nil, err
 		}
-		if exit.Exit.Epoch > slots.ToEpoch(slot) {
+		if p.staged[exit.Exit.ValidatorIndex] || exit.Exit.Epoch > slots.ToEpoch(slot) {
 			node, err = node.Next()
 			if err != nil {
 				p.lock.RUnlock()
@@ -109,11 +129,29 @@ func (p *Pool) ExitsForInclusion(state state.ReadOnlyBeaconState, slot types.Slo
 	return result, nil
 }
 
-// InsertVoluntaryExit into the pool.
+// InsertVoluntaryExit into the pool. A staged exit of the same validator is released,
+// which makes it available for inclusion in blocks.
 func (p *Pool) InsertVoluntaryExit(exit *ethpb.SignedVoluntaryExit) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
+	delete(p.staged, exit.Exit.ValidatorIndex)
+	_, exists := p.m[exit.Exit.ValidatorIndex]
+	if exists {
+		return
+	}
+
+	p.pending.Append(doublylinkedlist.NewNode(exit))
+	p.m[exit.Exit.ValidatorIndex] = p.pending.Last()
+}
+
+// StageVoluntaryExit inserts the exit into the pool without making it available for inclusion in blocks.
+// The exit stays staged until an exit of the same validator is inserted with InsertVoluntaryExit.
+// Nothing happens when the pool already holds an exit of the validator.
+func (p *Pool) StageVoluntaryExit(exit *ethpb.SignedVoluntaryExit) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	_, exists := p.m[exit.Exit.ValidatorIndex]
 	if exists {
 		return
@@ -121,6 +159,7 @@ func (p *Pool) InsertVoluntaryExit(exit *ethpb.SignedVoluntaryExit) {
 
 	p.pending.Append(doublylinkedlist.NewNode(exit))
 	p.m[exit.Exit.ValidatorIndex] = p.pending.Last()
+	p.staged[exit.Exit.ValidatorIndex] = true
 }
 
 // MarkIncluded is used when an exit has been included in a beacon block. Every block seen by this
@@ -135,5 +174,37 @@ func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
 	}
 
 	delete(p.m, exit.Exit.ValidatorIndex)
+	delete(p.staged, exit.Exit.ValidatorIndex)
+	p.pending.Remove(node)
+}
+
+// RemoveByValidatorIndex removes the pending exit of the validator from the pool and returns it.
+// When expectedRoot is not nil, the exit is only removed if the hash tree root of the signed exit matches it,
+// so that the exit cannot be replaced between reading and deleting it.
+func (p *Pool) RemoveByValidatorIndex(validatorIndex types.ValidatorIndex, expectedRoot *[32]byte) (*ethpb.SignedVoluntaryExit, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	node := p.m[validatorIndex]
+	if node == nil {
+		return nil, ErrExitNotFound
+	}
+	exit, err := node.Value()
+	if err != nil {
+		return nil, err
+	}
+	if expectedRoot != nil {
+		root, err := exit.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute exit root")
+		}
+		if root != *expectedRoot {
+			return nil, ErrExitRootMismatch
+		}
+	}
+
+	delete(p.m, validatorIndex)
+	delete(p.staged, validatorIndex)
 	p.pending.Remove(node)
+	return exit, nil
 }
@@ -43,6 +43,16 @@ func TestPendingExits(t *testing.T) {
 	})
 }
 
+func TestPendingExitCount(t *testing.T) {
+	pool := NewPool()
+	assert.Equal(t, 0, pool.PendingExitCount())
+	pool.InsertVoluntaryExit(&ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0}})
+	pool.InsertVoluntaryExit(&ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1}})
+	assert.Equal(t, 2, pool.PendingExitCount())
+	pool.MarkIncluded(&ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0}})
+	assert.Equal(t, 1, pool.PendingExitCount())
+}
+
 func TestExitsForInclusion(t *testing.T) {
 	spb := &ethpb.BeaconStateCapella{
 		Fork: &ethpb.Fork{
@@ -141,6 +151,17 @@ func TestExitsForInclusion(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(exits))
 	})
+	t.Run("staged exit not returned until released", func(t *testing.T) {
+		pool := NewPool()
+		pool.StageVoluntaryExit(signedExits[0])
+		exits, err := pool.ExitsForInclusion(st, stateSlot)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(exits))
+		pool.InsertVoluntaryExit(signedExits[0])
+		exits, err = pool.ExitsForInclusion(st, stateSlot)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(exits))
+	})
 	t.Run("invalid exit not returned", func(t *testing.T) {
 		pool := NewPool()
 		pool.InsertVoluntaryExit(signedExits[len(signedExits)-2])
@@ -220,6 +241,35 @@ func TestInsertExit(t *testing.T) {
 	})
 }
 
+func TestStageVoluntaryExit(t *testing.T) {
+	exit := func(idx types.ValidatorIndex) *ethpb.SignedVoluntaryExit {
+		return &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: idx}}
+	}
+	t.Run("staged until inserted", func(t *testing.T) {
+		pool := NewPool()
+		pool.StageVoluntaryExit(exit(0))
+		assert.Equal(t, 1, pool.PendingExitCount())
+		assert.Equal(t, true, pool.staged[0])
+		pool.InsertVoluntaryExit(exit(0))
+		assert.Equal(t, 1, pool.PendingExitCount())
+		assert.Equal(t, false, pool.staged[0])
+	})
+	t.Run("pending exit not staged", func(t *testing.T) {
+		pool := NewPool()
+		pool.InsertVoluntaryExit(exit(0))
+		pool.StageVoluntaryExit(exit(0))
+		assert.Equal(t, 1, pool.PendingExitCount())
+		assert.Equal(t, false, pool.staged[0])
+	})
+	t.Run("removed when included", func(t *testing.T) {
+		pool := NewPool()
+		pool.StageVoluntaryExit(exit(0))
+		pool.MarkIncluded(exit(0))
+		assert.Equal(t, 0, pool.PendingExitCount())
+		assert.Equal(t, 0, len(pool.staged))
+	})
+}
+
 func TestMarkIncluded(t *testing.T) {
 	t.Run("one element in pool", func(t *testing.T) {
 		pool := NewPool()
@@ -325,3 +375,54 @@ func TestMarkIncluded(t *testing.T) {
 		assert.NotNil(t, pool.m[1])
 	})
 }
+
+func TestRemoveByValidatorIndex(t *testing.T) {
+	newPool := func() (*Pool, *ethpb.SignedVoluntaryExit) {
+		pool := NewPool()
+		first := &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{ValidatorIndex: 0},
+			Signature: make([]byte, 96),
+		}
+		second := &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{ValidatorIndex: 1},
+			Signature: make([]byte, 96),
+		}
+		pool.InsertVoluntaryExit(first)
+		pool.InsertVoluntaryExit(second)
+		return pool, second
+	}
+
+	t.Run("without expected root", func(t *testing.T) {
+		pool, second := newPool()
+		exit, err := pool.RemoveByValidatorIndex(1, nil)
+		require.NoError(t, err)
+		assert.DeepEqual(t, second, exit)
+		assert.Equal(t, 1, pool.pending.Len())
+		_, ok := pool.m[1]
+		assert.Equal(t, false, ok)
+	})
+	t.Run("matching expected root", func(t *testing.T) {
+		pool, second := newPool()
+		root, err := second.HashTreeRoot()
+		require.NoError(t, err)
+		exit, err := pool.RemoveByValidatorIndex(1, &root)
+		require.NoError(t, err)
+		assert.DeepEqual(t, second, exit)
+		assert.Equal(t, 1, pool.pending.Len())
+	})
+	t.Run("mismatching expected root", func(t *testing.T) {
+		pool, _ := newPool()
+		root := [32]byte{'a'}
+		_, err := pool.RemoveByValidatorIndex(1, &root)
+		require.ErrorIs(t, err, ErrExitRootMismatch)
+		assert.Equal(t, 2, pool.pending.Len())
+		_, ok := pool.m[1]
+		assert.Equal(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		pool, _ := newPool()
+		_, err := pool.RemoveByValidatorIndex(2, nil)
+		require.ErrorIs(t, err, ErrExitNotFound)
+		assert.Equal(t, 2, pool.pending.Len())
+	})
+}
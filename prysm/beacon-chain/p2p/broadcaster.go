@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
@@ -297,6 +298,17 @@ func (s *Service) broadcastObject(ctx context.Context, obj ssz.Marshaler, topic
 	return nil
 }
 
+// AttestationSubnetPeers returns the peers currently known to be subscribed to the attestation subnet topic of the current fork.
+// These are the candidate publish targets of an attestation broadcast on that subnet; gossipsub does not report which of
+// them actually received a given message.
+func (s *Service) AttestationSubnetPeers(subnet uint64) ([]peer.ID, error) {
+	forkDigest, err := s.currentForkDigest()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve fork digest")
+	}
+	return s.pubsub.ListPeers(attestationToTopic(subnet, forkDigest) + s.Encoding().ProtocolSuffix()), nil
+}
+
 func attestationToTopic(subnet uint64, forkDigest [4]byte) string {
 	return fmt.Sprintf(AttestationSubnetTopicFormat, forkDigest, subnet)
 }
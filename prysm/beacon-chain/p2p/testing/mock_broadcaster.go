@@ -5,6 +5,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -14,8 +15,13 @@ type MockBroadcaster struct {
 	BroadcastCalled       atomic.Bool
 	BroadcastMessages     []proto.Message
 	BroadcastAttestations []ethpb.Att
-	msgLock               sync.Mutex
-	attLock               sync.Mutex
+	SubnetPeers           map[uint64][]peer.ID
+	// SubnetPeersErr is returned by AttestationSubnetPeers when set.
+	SubnetPeersErr error
+	// AttestationErr is returned by BroadcastAttestation when set.
+	AttestationErr error
+	msgLock        sync.Mutex
+	attLock        sync.Mutex
 }
 
 // Broadcast records a broadcast occurred.
@@ -30,12 +36,23 @@ func (m *MockBroadcaster) Broadcast(_ context.Context, msg proto.Message) error
 // BroadcastAttestation records a broadcast occurred.
 func (m *MockBroadcaster) BroadcastAttestation(_ context.Context, _ uint64, a ethpb.Att) error {
 	m.BroadcastCalled.Store(true)
+	if m.AttestationErr != nil {
+		return m.AttestationErr
+	}
 	m.attLock.Lock()
 	defer m.attLock.Unlock()
 	m.BroadcastAttestations = append(m.BroadcastAttestations, a)
 	return nil
 }
 
+// AttestationSubnetPeers returns the peers configured for the subnet.
+func (m *MockBroadcaster) AttestationSubnetPeers(subnet uint64) ([]peer.ID, error) {
+	if m.SubnetPeersErr != nil {
+		return nil, m.SubnetPeersErr
+	}
+	return m.SubnetPeers[subnet], nil
+}
+
 // BroadcastSyncCommitteeMessage records a broadcast occurred.
 func (m *MockBroadcaster) BroadcastSyncCommitteeMessage(_ context.Context, _ uint64, _ *ethpb.SyncCommitteeMessage) error {
 	m.BroadcastCalled.Store(true)
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VerificationLevel determines how thoroughly submitted attestations are verified before they are pooled and broadcast.
+type VerificationLevel string
+
+const (
+	// VerificationLevelMinimal only performs structural checks of submitted attestations.
+	VerificationLevelMinimal VerificationLevel = "minimal"
+	// VerificationLevelStandard additionally checks that attestation signatures are valid BLS signatures.
+	VerificationLevelStandard VerificationLevel = "standard"
+	// VerificationLevelStrict verifies attestation signatures against the attesting committee in the head state.
+	VerificationLevelStrict VerificationLevel = "strict"
+)
+
+// VerificationLevelFromString converts a string to a VerificationLevel.
+// An empty string results in VerificationLevelStandard.
+func VerificationLevelFromString(v string) (VerificationLevel, error) {
+	switch VerificationLevel(strings.ToLower(v)) {
+	case "", VerificationLevelStandard:
+		return VerificationLevelStandard, nil
+	case VerificationLevelMinimal:
+		return VerificationLevelMinimal, nil
+	case VerificationLevelStrict:
+		return VerificationLevelStrict, nil
+	default:
+		return "", fmt.Errorf("unknown verification level %s", v)
+	}
+}
+
+// AtLeast returns true if the level performs all checks performed by the other level.
+// An empty level is treated as VerificationLevelStandard.
+func (l VerificationLevel) AtLeast(other VerificationLevel) bool {
+	return l.rank() >= other.rank()
+}
+
+func (l VerificationLevel) rank() int {
+	switch l {
+	case VerificationLevelMinimal:
+		return 0
+	case VerificationLevelStrict:
+		return 2
+	default:
+		return 1
+	}
+}
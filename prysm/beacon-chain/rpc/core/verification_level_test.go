@@ -0,0 +1,27 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestVerificationLevelFromString(t *testing.T) {
+	level, err := VerificationLevelFromString("")
+	require.NoError(t, err)
+	assert.Equal(t, VerificationLevelStandard, level)
+	level, err = VerificationLevelFromString("STRICT")
+	require.NoError(t, err)
+	assert.Equal(t, VerificationLevelStrict, level)
+	_, err = VerificationLevelFromString("foo")
+	assert.ErrorContains(t, "unknown verification level foo", err)
+}
+
+func TestVerificationLevel_AtLeast(t *testing.T) {
+	assert.Equal(t, true, VerificationLevelStrict.AtLeast(VerificationLevelStandard))
+	assert.Equal(t, true, VerificationLevelStandard.AtLeast(VerificationLevelStandard))
+	assert.Equal(t, true, VerificationLevelStandard.AtLeast(""))
+	assert.Equal(t, false, VerificationLevelMinimal.AtLeast(VerificationLevelStandard))
+	assert.Equal(t, false, VerificationLevelStandard.AtLeast(VerificationLevelStrict))
+}
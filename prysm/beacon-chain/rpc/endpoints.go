@@ -26,6 +26,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 )
 
+// poolResponseCompressionThreshold is the minimum size in bytes of pool read responses that are compressed.
+const poolResponseCompressionThreshold = 1024
+
 type endpoint struct {
 	template   string
 	name       string
@@ -473,30 +476,42 @@ func (s *Service) beaconEndpoints(
 	coreService *core.Service,
 ) []endpoint {
 	server := &beacon.Server{
-		CanonicalHistory:        ch,
-		BeaconDB:                s.cfg.BeaconDB,
-		AttestationsPool:        s.cfg.AttestationsPool,
-		SlashingsPool:           s.cfg.SlashingsPool,
-		ChainInfoFetcher:        s.cfg.ChainInfoFetcher,
-		GenesisTimeFetcher:      s.cfg.GenesisTimeFetcher,
-		BlockNotifier:           s.cfg.BlockNotifier,
-		OperationNotifier:       s.cfg.OperationNotifier,
-		Broadcaster:             s.cfg.Broadcaster,
-		BlockReceiver:           s.cfg.BlockReceiver,
-		StateGenService:         s.cfg.StateGen,
-		Stater:                  stater,
-		Blocker:                 blocker,
-		OptimisticModeFetcher:   s.cfg.OptimisticModeFetcher,
-		HeadFetcher:             s.cfg.HeadFetcher,
-		TimeFetcher:             s.cfg.GenesisTimeFetcher,
-		VoluntaryExitsPool:      s.cfg.ExitPool,
-		V1Alpha1ValidatorServer: validatorServer,
-		SyncChecker:             s.cfg.SyncService,
-		ExecutionReconstructor:  s.cfg.ExecutionReconstructor,
-		BLSChangesPool:          s.cfg.BLSChangesPool,
-		FinalizationFetcher:     s.cfg.FinalizationFetcher,
-		ForkchoiceFetcher:       s.cfg.ForkchoiceFetcher,
-		CoreService:             coreService,
+		CanonicalHistory:             ch,
+		BeaconDB:                     s.cfg.BeaconDB,
+		AttestationsPool:             s.cfg.AttestationsPool,
+		SlashingsPool:                s.cfg.SlashingsPool,
+		ChainInfoFetcher:             s.cfg.ChainInfoFetcher,
+		GenesisTimeFetcher:           s.cfg.GenesisTimeFetcher,
+		BlockNotifier:                s.cfg.BlockNotifier,
+		OperationNotifier:            s.cfg.OperationNotifier,
+		Broadcaster:                  s.cfg.Broadcaster,
+		BlockReceiver:                s.cfg.BlockReceiver,
+		StateGenService:              s.cfg.StateGen,
+		Stater:                       stater,
+		Blocker:                      blocker,
+		OptimisticModeFetcher:        s.cfg.OptimisticModeFetcher,
+		HeadFetcher:                  s.cfg.HeadFetcher,
+		TimeFetcher:                  s.cfg.GenesisTimeFetcher,
+		VoluntaryExitsPool:           s.cfg.ExitPool,
+		V1Alpha1ValidatorServer:      validatorServer,
+		AttestationPacker:            validatorServer,
+		SyncChecker:                  s.cfg.SyncService,
+		ExecutionReconstructor:       s.cfg.ExecutionReconstructor,
+		BLSChangesPool:               s.cfg.BLSChangesPool,
+		FinalizationFetcher:          s.cfg.FinalizationFetcher,
+		ForkchoiceFetcher:            s.cfg.ForkchoiceFetcher,
+		CoreService:                  coreService,
+		AttestationVerificationLevel: s.cfg.AttestationVerification,
+		AttestationPoolSnapshots:     beacon.NewAttestationPoolSnapshots(),
+		BroadcastFailures:            beacon.NewBroadcastFailures(),
+		SubmissionRejections:         beacon.NewSubmissionRejections(),
+		SubmittedSlashings:           beacon.NewSubmittedSlashings(),
+		AttestationReceipts:          beacon.NewAttestationReceipts(),
+		PeersFetcher:                 s.cfg.PeersFetcher,
+		MinAttestationBroadcastPeers: s.cfg.MinAttBroadcastPeers,
+		VerifyAttestationSource:      s.cfg.VerifyAttSource,
+		VerifyAttestationCommittee:   s.cfg.VerifyAttCommittee,
+		MaxListResponseSize:          s.cfg.MaxListResponseSize,
 	}
 
 	const namespace = "beacon"
@@ -636,6 +651,7 @@ func (s *Service) beaconEndpoints(
 			name:     namespace + ".ListAttestations",
 			middleware: []middleware.Middleware{
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.CompressionHandler(poolResponseCompressionThreshold),
 			},
 			handler: server.ListAttestations,
 			methods: []string{http.MethodGet},
@@ -645,26 +661,288 @@ func (s *Service) beaconEndpoints(
 			name:     namespace + ".ListAttestationsV2",
 			middleware: []middleware.Middleware{
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.CompressionHandler(poolResponseCompressionThreshold),
 			},
 			handler: server.ListAttestationsV2,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/beacon/pool/aggregate_attestations",
+			name:     namespace + ".ListAttestationsByAggregator",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListAttestationsByAggregator,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/validator",
+			name:     namespace + ".ListAttestationsByValidator",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListAttestationsByValidator,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/diff",
+			name:     namespace + ".GetAttestationPoolDiff",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationPoolDiff,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/validate",
+			name:     namespace + ".ValidateAttestations",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ValidateAttestations,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/aggregate_and_proofs",
+			name:     namespace + ".SubmitAggregateAndProofs",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.SubmitAggregateAndProofs,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/aggregate",
+			name:     namespace + ".SubmitAttestationsAndAggregate",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.SubmitAttestationsAndAggregate,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/block_roots",
+			name:     namespace + ".ListAttestationVotesByBlockRoot",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListAttestationVotesByBlockRoot,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/best",
+			name:     namespace + ".GetBestAttestation",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBestAttestation,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/prunable",
+			name:     namespace + ".ListPrunableAttestations",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListPrunableAttestations,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/format_counts",
+			name:     namespace + ".GetAttestationPoolFormatCounts",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationPoolFormatCounts,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/validator_count",
+			name:     namespace + ".GetAttestationPoolValidatorCount",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationPoolValidatorCount,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/coverage",
+			name:     namespace + ".GetAttestationPoolCoverage",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationPoolCoverage,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/slot_histogram",
+			name:     namespace + ".GetAttestationPoolSlotHistogram",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationPoolSlotHistogram,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/protobuf",
+			name:     namespace + ".SubmitAttestationProtobuf",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.ProtobufMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
+			},
+			handler: server.SubmitAttestationProtobuf,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/age",
+			name:     namespace + ".GetAttestationPoolAge",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationPoolAge,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/bls_to_execution_changes/status",
+			name:     namespace + ".GetBLSToExecutionChangesStatus",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBLSToExecutionChangesStatus,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/receipts/{receipt_id}",
+			name:     namespace + ".GetAttestationReceipt",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationReceipt,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/validator",
+			name:     namespace + ".GetValidatorPoolStatus",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetValidatorPoolStatus,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/voluntary_exits/queue",
+			name:     namespace + ".GetVoluntaryExitQueue",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetVoluntaryExitQueue,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/voluntary_exits/batch",
+			name:     namespace + ".SubmitVoluntaryExits",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType, api.MultipartFormDataMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
+			},
+			handler: server.SubmitVoluntaryExits,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/slashings/prune",
+			name:     namespace + ".PruneSlashings",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.PruneSlashings,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/export",
+			name:     namespace + ".ExportPool",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ExportPool,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/slashings/{slashing_root}/status",
+			name:     namespace + ".GetSlashingStatus",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetSlashingStatus,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/inclusion",
+			name:     namespace + ".GetAttestationsForInclusion",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationsForInclusion,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/attestations/eligible",
+			name:     namespace + ".GetEligibleAttestations",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetEligibleAttestations,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/broadcast_failures",
+			name:     namespace + ".ListBroadcastFailures",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ListBroadcastFailures,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/pool/rejections",
+			name:     namespace + ".GetSubmissionRejections",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetSubmissionRejections,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/eth/v1/beacon/pool/attestations",
 			name:     namespace + ".SubmitAttestations",
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
-				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.NdjsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitAttestations,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/eth/v1/beacon/pool/attestations/{attestation_data_root}",
+			name:     namespace + ".GetPoolAttestation",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPoolAttestation,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/eth/v2/beacon/pool/attestations",
 			name:     namespace + ".SubmitAttestationsV2",
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitAttestationsV2,
 			methods: []string{http.MethodPost},
@@ -674,6 +952,7 @@ func (s *Service) beaconEndpoints(
 			name:     namespace + ".ListVoluntaryExits",
 			middleware: []middleware.Middleware{
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.CompressionHandler(poolResponseCompressionThreshold),
 			},
 			handler: server.ListVoluntaryExits,
 			methods: []string{http.MethodGet},
@@ -682,18 +961,29 @@ func (s *Service) beaconEndpoints(
 			template: "/eth/v1/beacon/pool/voluntary_exits",
 			name:     namespace + ".SubmitVoluntaryExit",
 			middleware: []middleware.Middleware{
-				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.ContentTypeHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitVoluntaryExit,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/eth/v1/beacon/pool/voluntary_exits/{validator_index}",
+			name:     namespace + ".DeleteVoluntaryExit",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.DeleteVoluntaryExit,
+			methods: []string{http.MethodDelete},
+		},
 		{
 			template: "/eth/v1/beacon/pool/sync_committees",
 			name:     namespace + ".SubmitSyncCommitteeSignatures",
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitSyncCommitteeSignatures,
 			methods: []string{http.MethodPost},
@@ -703,6 +993,7 @@ func (s *Service) beaconEndpoints(
 			name:     namespace + ".ListBLSToExecutionChanges",
 			middleware: []middleware.Middleware{
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.CompressionHandler(poolResponseCompressionThreshold),
 			},
 			handler: server.ListBLSToExecutionChanges,
 			methods: []string{http.MethodGet},
@@ -713,6 +1004,7 @@ func (s *Service) beaconEndpoints(
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitBLSToExecutionChanges,
 			methods: []string{http.MethodPost},
@@ -722,6 +1014,7 @@ func (s *Service) beaconEndpoints(
 			name:     namespace + ".GetAttesterSlashings",
 			middleware: []middleware.Middleware{
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.CompressionHandler(poolResponseCompressionThreshold),
 			},
 			handler: server.GetAttesterSlashings,
 			methods: []string{http.MethodGet},
@@ -731,6 +1024,7 @@ func (s *Service) beaconEndpoints(
 			name:     namespace + ".GetAttesterSlashingsV2",
 			middleware: []middleware.Middleware{
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.CompressionHandler(poolResponseCompressionThreshold),
 			},
 			handler: server.GetAttesterSlashingsV2,
 			methods: []string{http.MethodGet},
@@ -741,6 +1035,7 @@ func (s *Service) beaconEndpoints(
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitAttesterSlashings,
 			methods: []string{http.MethodPost},
@@ -751,6 +1046,7 @@ func (s *Service) beaconEndpoints(
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitAttesterSlashingsV2,
 			methods: []string{http.MethodPost},
@@ -759,7 +1055,8 @@ func (s *Service) beaconEndpoints(
 			template: "/eth/v1/beacon/pool/proposer_slashings",
 			name:     namespace + ".GetProposerSlashings",
 			middleware: []middleware.Middleware{
-				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
+				middleware.CompressionHandler(poolResponseCompressionThreshold),
 			},
 			handler: server.GetProposerSlashings,
 			methods: []string{http.MethodGet},
@@ -770,10 +1067,20 @@ func (s *Service) beaconEndpoints(
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.PreferMinimalResponseHandler,
 			},
 			handler: server.SubmitProposerSlashing,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/eth/v1/beacon/pool/stats",
+			name:     namespace + ".GetPoolStats",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPoolStats,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/eth/v1/beacon/headers",
 			name:     namespace + ".GetBlockHeaders",
@@ -997,6 +1304,33 @@ func (s *Service) eventsEndpoints() []endpoint {
 			handler: server.StreamEvents,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/events/slashings",
+			name:     namespace + ".StreamSlashings",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.EventStreamMediaType}),
+			},
+			handler: server.StreamSlashings,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/events/bls_to_execution_changes",
+			name:     namespace + ".StreamBLSToExecutionChanges",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.EventStreamMediaType}),
+			},
+			handler: server.StreamBLSToExecutionChanges,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/eth/v1/beacon/pool/attestations/stream",
+			name:     namespace + ".StreamPoolAttestations",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.EventStreamMediaType}),
+			},
+			handler: server.StreamPoolAttestations,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
 
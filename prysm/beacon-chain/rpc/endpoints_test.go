@@ -41,13 +41,43 @@ func Test_endpoints(t *testing.T) {
 		"/eth/v1/beacon/deposit_snapshot":                            {http.MethodGet},
 		"/eth/v1/beacon/blinded_blocks/{block_id}":                   {http.MethodGet},
 		"/eth/v1/beacon/pool/attestations":                           {http.MethodGet, http.MethodPost},
+		"/eth/v1/beacon/pool/attestations/{attestation_data_root}":   {http.MethodGet},
 		"/eth/v2/beacon/pool/attestations":                           {http.MethodGet, http.MethodPost},
 		"/eth/v1/beacon/pool/attester_slashings":                     {http.MethodGet, http.MethodPost},
 		"/eth/v2/beacon/pool/attester_slashings":                     {http.MethodGet, http.MethodPost},
 		"/eth/v1/beacon/pool/proposer_slashings":                     {http.MethodGet, http.MethodPost},
 		"/eth/v1/beacon/pool/sync_committees":                        {http.MethodPost},
 		"/eth/v1/beacon/pool/voluntary_exits":                        {http.MethodGet, http.MethodPost},
+		"/eth/v1/beacon/pool/voluntary_exits/{validator_index}":      {http.MethodDelete},
 		"/eth/v1/beacon/pool/bls_to_execution_changes":               {http.MethodGet, http.MethodPost},
+		"/eth/v1/beacon/pool/stats":                                  {http.MethodGet},
+		"/prysm/v1/beacon/pool/aggregate_attestations":               {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/validator":               {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/diff":                    {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/validate":                {http.MethodPost},
+		"/prysm/v1/beacon/pool/aggregate_and_proofs":                 {http.MethodPost},
+		"/prysm/v1/beacon/pool/attestations/aggregate":               {http.MethodPost},
+		"/prysm/v1/beacon/pool/attestations/block_roots":             {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/best":                    {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/prunable":                {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/format_counts":           {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/validator_count":         {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/coverage":                {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/slot_histogram":          {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/age":                     {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/protobuf":                {http.MethodPost},
+		"/prysm/v1/beacon/pool/bls_to_execution_changes/status":      {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/receipts/{receipt_id}":   {http.MethodGet},
+		"/prysm/v1/beacon/pool/validator":                            {http.MethodGet},
+		"/prysm/v1/beacon/pool/voluntary_exits/queue":                {http.MethodGet},
+		"/prysm/v1/beacon/pool/voluntary_exits/batch":                {http.MethodPost},
+		"/prysm/v1/beacon/pool/slashings/prune":                      {http.MethodPost},
+		"/prysm/v1/beacon/pool/export":                               {http.MethodGet},
+		"/prysm/v1/beacon/pool/slashings/{slashing_root}/status":     {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/inclusion":               {http.MethodGet},
+		"/prysm/v1/beacon/pool/attestations/eligible":                {http.MethodGet},
+		"/prysm/v1/beacon/pool/broadcast_failures":                   {http.MethodGet},
+		"/prysm/v1/beacon/pool/rejections":                           {http.MethodGet},
 		"/prysm/v1/beacon/individual_votes":                          {http.MethodPost},
 	}
 
@@ -79,7 +109,10 @@ func Test_endpoints(t *testing.T) {
 	}
 
 	eventsRoutes := map[string][]string{
-		"/eth/v1/events": {http.MethodGet},
+		"/eth/v1/events":                            {http.MethodGet},
+		"/prysm/v1/events/slashings":                {http.MethodGet},
+		"/prysm/v1/events/bls_to_execution_changes": {http.MethodGet},
+		"/eth/v1/beacon/pool/attestations/stream":   {http.MethodGet},
 	}
 
 	nodeRoutes := map[string][]string{
@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"strconv"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+)
+
+// Outcomes reported for the attestations of an attestation receipt.
+const (
+	// AttestationReceiptPooled is reported for attestations that were saved to the pool
+	// without being broadcast, because broadcasting was deferred.
+	AttestationReceiptPooled = "pooled"
+	// AttestationReceiptBroadcast is reported for attestations that were handed to the p2p service for broadcasting
+	// and saved to the pool. Publishing happens asynchronously, so it does not mean that any peer received them.
+	AttestationReceiptBroadcast = "broadcast"
+	// AttestationReceiptRetryPending is reported for attestations that could not be broadcast and were saved to the pool,
+	// from which they can be re-broadcast, because the SaveBroadcastFailedAttestations feature is enabled.
+	AttestationReceiptRetryPending = "retry-pending"
+	// AttestationReceiptFailed is reported for attestations that failed validation, and for attestations that could
+	// not be broadcast and were dropped.
+	AttestationReceiptFailed = "failed"
+)
+
+// maxAttestationReceipts defines the max number of attestation receipts that are retained.
+var maxAttestationReceipts = 1024
+
+// AttestationReceiptOutcome is the outcome of a single attestation of a submission request.
+type AttestationReceiptOutcome struct {
+	// Index is the position of the attestation in the submission request.
+	Index   int
+	Status  string
+	Message string
+}
+
+type attestationReceipt struct {
+	outcomes []AttestationReceiptOutcome
+}
+
+// AttestationReceipts retains the outcomes of recent attestation submissions, keyed by the receipt ID
+// returned to the client. Once full, the least recently used receipt is evicted.
+type AttestationReceipts struct {
+	lock  sync.RWMutex
+	cache *lru.Cache
+}
+
+// NewAttestationReceipts creates a new cache of attestation receipts.
+func NewAttestationReceipts() *AttestationReceipts {
+	return &AttestationReceipts{
+		cache: lruwrpr.New(maxAttestationReceipts),
+	}
+}
+
+// Create registers a new receipt without outcomes and returns its ID. It returns an empty ID on a nil cache.
+func (r *AttestationReceipts) Create() string {
+	if r == nil {
+		return ""
+	}
+	id := strconv.FormatUint(rand.NewGenerator().Uint64(), 16)
+	r.cache.Add(id, &attestationReceipt{})
+	return id
+}
+
+// Record adds the outcome of an attestation to the receipt. It is a no-op on a nil cache
+// or when the receipt is unknown.
+func (r *AttestationReceipts) Record(id string, outcome AttestationReceiptOutcome) {
+	if r == nil {
+		return
+	}
+	item, ok := r.cache.Get(id)
+	if !ok {
+		return
+	}
+	receipt, ok := item.(*attestationReceipt)
+	if !ok {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	receipt.outcomes = append(receipt.outcomes, outcome)
+}
+
+// Get returns the outcomes recorded so far for the receipt, in the order they became known.
+// The second return value is false when the receipt is unknown or was evicted.
+func (r *AttestationReceipts) Get(id string) ([]AttestationReceiptOutcome, bool) {
+	if r == nil {
+		return nil, false
+	}
+	item, ok := r.cache.Get(id)
+	if !ok {
+		return nil, false
+	}
+	receipt, ok := item.(*attestationReceipt)
+	if !ok {
+		return nil, false
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return append([]AttestationReceiptOutcome{}, receipt.outcomes...), true
+}
@@ -0,0 +1,69 @@
+package beacon
+
+import (
+	"sync"
+	"time"
+)
+
+// maxBroadcastFailures defines the max number of recent broadcast failures that are retained.
+var maxBroadcastFailures = 256
+
+// BroadcastFailure describes a single operation that could not be broadcast to the network.
+type BroadcastFailure struct {
+	// Operation is the kind of the operation, e.g. "attestation".
+	Operation string
+	// Index is the position of the operation in the submission request.
+	Index int
+	// Root is the hash tree root identifying the operation.
+	Root [32]byte
+	// Subnet is the subnet the operation was published on. It is only meaningful when HasSubnet is true.
+	Subnet    uint64
+	HasSubnet bool
+	Err       string
+	Time      time.Time
+}
+
+// BroadcastFailures is a bounded ring buffer of recent broadcast failures.
+// Once full, the oldest failure is overwritten by every new one.
+type BroadcastFailures struct {
+	lock     sync.RWMutex
+	failures []BroadcastFailure
+	next     int
+	full     bool
+}
+
+// NewBroadcastFailures creates a new ring buffer of broadcast failures.
+func NewBroadcastFailures() *BroadcastFailures {
+	return &BroadcastFailures{
+		failures: make([]BroadcastFailure, maxBroadcastFailures),
+	}
+}
+
+// Record adds a failure to the buffer. It is a no-op on a nil buffer.
+func (b *BroadcastFailures) Record(f BroadcastFailure) {
+	if b == nil {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.failures[b.next] = f
+	b.next = (b.next + 1) % len(b.failures)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// Recent returns the retained failures, ordered from the oldest to the most recent.
+func (b *BroadcastFailures) Recent() []BroadcastFailure {
+	if b == nil {
+		return []BroadcastFailure{}
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	if !b.full {
+		return append([]BroadcastFailure{}, b.failures[:b.next]...)
+	}
+	recent := make([]BroadcastFailure, 0, len(b.failures))
+	recent = append(recent, b.failures[b.next:]...)
+	return append(recent, b.failures[:b.next]...)
+}
@@ -1,16 +1,25 @@
 package beacon
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
@@ -18,36 +27,111 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	corehelpers "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	consensus_types "github.com/prysmaticlabs/prysm/v5/consensus-types"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+	attaggregation "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation/aggregation/attestations"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
 )
 
-const broadcastBLSChangesRateLimit = 128
+const (
+	defaultBLSChangesBroadcastRateLimit = 128
+	defaultBLSChangesBroadcastInterval  = 500 * time.Millisecond
+)
 
-// ListAttestations retrieves attestations known by the node but
-// not necessarily incorporated into any block. Allows filtering by committee index or slot.
+const (
+	broadcastFailureRetained = "retained"
+	broadcastFailureDropped  = "dropped"
+)
+
+// ListAttestations retrieves pre-Electra attestations known by the node but not necessarily incorporated into any block.
+// ListAttestationsV2 should be used for Electra attestations, other pooled attestations are skipped.
+// Supported query parameters, all of which apply together:
+//   - `slot`, `committee_index`: the attestation data must match. Committee indices out of range for the slot are rejected.
+//   - `epoch`: the attestation must be for a slot of the epoch. It cannot be combined with `slot`.
+//   - `since_slot`: the attestation must be for a slot strictly greater than it.
+//   - `source_epoch`, `target_epoch`: the FFG checkpoints of the attestation must be at the epochs.
+//   - `singleton_only`: the attestation must have exactly one aggregation bit set.
+//   - `include_ssz`: every attestation carries an additional base64-encoded `ssz` field.
+//   - `limit`, `offset`: paginate the matching attestations, whose number is returned in `total`.
+//
+// Attestations whose aggregation bits are covered by another pooled attestation with the same data, such as an
+// unaggregated attestation that is also part of a pooled aggregate, are suppressed. Attestations are ordered by slot,
+// then by data root and then by aggregation bits. Responses exceeding MaxListResponseSize are truncated.
 func (s *Server) ListAttestations(w http.ResponseWriter, r *http.Request) {
-	_, span := trace.StartSpan(r.Context(), "beacon.ListAttestations")
+	ctx, span := trace.StartSpan(r.Context(), "beacon.ListAttestations")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	rawSlot, slot, ok := shared.UintFromQuery(w, r, "slot", false)
 	if !ok {
 		return
 	}
-	rawCommitteeIndex, committeeIndex, ok := shared.UintFromQuery(w, r, "committee_index", false)
+	committeeIndices, ok := committeeIndicesFromQuery(w, r)
+	if !ok {
+		return
+	}
+	includeSSZ, ok := shared.BoolFromQuery(w, r, "include_ssz")
+	if !ok {
+		return
+	}
+	singletonOnly, ok := shared.BoolFromQuery(w, r, "singleton_only")
+	if !ok {
+		return
+	}
+	rawSinceSlot, sinceSlot, ok := shared.UintFromQuery(w, r, "since_slot", false)
+	if !ok {
+		return
+	}
+	rawEpoch, epoch, ok := epochFromQuery(w, r, rawSlot)
 	if !ok {
 		return
 	}
+	ffgEpochs, ok := ffgEpochsFromQuery(w, r)
+	if !ok {
+		return
+	}
+	rawLimit, limit, ok := shared.UintFromQuery(w, r, "limit", false)
+	if !ok {
+		return
+	}
+	_, offset, ok := shared.UintFromQuery(w, r, "offset", false)
+	if !ok {
+		return
+	}
+
+	if len(committeeIndices) > 0 {
+		headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+		if err != nil {
+			httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !validateCommitteeIndices(ctx, w, headState, rawSlot, slot, rawEpoch, epoch, committeeIndices) {
+			return
+		}
+	}
 
 	attestations := s.AttestationsPool.AggregatedAttestations()
 	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
@@ -55,55 +139,147 @@ func (s *Server) ListAttestations(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	aggregatedCount := len(attestations)
 	attestations = append(attestations, unaggAtts...)
+	w.Header().Set(api.PoolSizeHeader, strconv.Itoa(len(attestations)))
+	attestations, err = dedupAttestations(attestations)
+	if err != nil {
+		httputil.HandleError(w, "Could not deduplicate attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
-	filteredAtts := make([]*structs.Attestation, 0, len(attestations))
+	filteredAtts := make([]interface{}, 0, len(attestations))
 	for _, a := range attestations {
 		var includeAttestation bool
 		att, ok := a.(*eth.Attestation)
 		if !ok {
-			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", a), http.StatusInternalServerError)
-			return
+			listAttestationsTypeMismatchCount.Inc()
+			log.WithField("type", fmt.Sprintf("%T", a)).Debug("Skipping attestation that is not a pre-Electra attestation")
+			continue
 		}
 
-		includeAttestation = shouldIncludeAttestation(att.GetData(), rawSlot, slot, rawCommitteeIndex, committeeIndex)
+		includeAttestation = shouldIncludeAttestation(att, rawSlot, slot, committeeIndices, ffgEpochs) &&
+			(!singletonOnly || isSingletonAttestation(att)) &&
+			(rawSinceSlot == "" || att.Data.Slot > primitives.Slot(sinceSlot)) &&
+			(rawEpoch == "" || slots.ToEpoch(att.Data.Slot) == epoch)
 		if includeAttestation {
-			attStruct := structs.AttFromConsensus(att)
-			filteredAtts = append(filteredAtts, attStruct)
+			item, err := s.listedAttestation(att, includeSSZ, false)
+			if err != nil {
+				httputil.HandleError(w, "Could not convert attestation: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			filteredAtts = append(filteredAtts, item)
 		}
 	}
 
-	attsData, err := json.Marshal(filteredAtts)
+	total := len(filteredAtts)
+	recordListedAttestations(version.String(version.Phase0), aggregatedCount, len(unaggAtts), total)
+	attsData, err := s.marshalListData(w, paginateListData(filteredAtts, rawLimit, limit, offset), total)
 	if err != nil {
 		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	httputil.WriteJson(w, &structs.ListAttestationsResponse{
-		Data: attsData,
+		Total: strconv.Itoa(total),
+		Data:  attsData,
 	})
 }
 
 // ListAttestationsV2 retrieves attestations known by the node but
 // not necessarily incorporated into any block. Allows filtering by committee index or slot.
+// Supports the same `include_ssz`, `singleton_only`, `since_slot`, `epoch`, `source_epoch`, `target_epoch`, `limit` and `offset`
+// query parameters as ListAttestations,
+// and rejects out of range `committee_index` values in the same way. Covered attestations are suppressed and attestations
+// are ordered in the same way as well; Electra attestations are only compared when their committee bits match.
+// The numbers of pooled and returned attestations are recorded in metrics labeled by the version of the head state.
+// With `include_source=true`, every attestation is annotated with the source through which it entered the pool:
+// `rpc` for attestations including an attestation submitted through this API, and `gossip` otherwise.
+// Sources are only recorded when the EnableAttestationSourceTagging feature is enabled, and the parameter is
+// rejected otherwise.
+// With `non_redundant=true`, the matching attestations are reduced to a covering set: attestations are visited
+// in decreasing order of set aggregation bits and only those that add a validator not yet covered by the previously
+// returned attestations with the same data are returned, in the same order as the other attestations.
+// The optional `min_participants` and `max_participants` query parameters restrict the result to attestations
+// whose number of set aggregation bits is within the inclusive range. A minimum greater than the maximum is rejected.
 func (s *Server) ListAttestationsV2(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.ListAttestationsV2")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	rawSlot, slot, ok := shared.UintFromQuery(w, r, "slot", false)
 	if !ok {
 		return
 	}
-	rawCommitteeIndex, committeeIndex, ok := shared.UintFromQuery(w, r, "committee_index", false)
+	committeeIndices, ok := committeeIndicesFromQuery(w, r)
+	if !ok {
+		return
+	}
+	includeSSZ, ok := shared.BoolFromQuery(w, r, "include_ssz")
+	if !ok {
+		return
+	}
+	singletonOnly, ok := shared.BoolFromQuery(w, r, "singleton_only")
+	if !ok {
+		return
+	}
+	rawSinceSlot, sinceSlot, ok := shared.UintFromQuery(w, r, "since_slot", false)
+	if !ok {
+		return
+	}
+	rawEpoch, epoch, ok := epochFromQuery(w, r, rawSlot)
+	if !ok {
+		return
+	}
+	ffgEpochs, ok := ffgEpochsFromQuery(w, r)
+	if !ok {
+		return
+	}
+	rawLimit, limit, ok := shared.UintFromQuery(w, r, "limit", false)
+	if !ok {
+		return
+	}
+	_, offset, ok := shared.UintFromQuery(w, r, "offset", false)
+	if !ok {
+		return
+	}
+	includeSource, ok := shared.BoolFromQuery(w, r, "include_source")
+	if !ok {
+		return
+	}
+	if includeSource && !features.Get().EnableAttestationSourceTagging {
+		httputil.HandleError(w, "Attestation sources are not recorded: the attestation source tagging feature is disabled", http.StatusNotImplemented)
+		return
+	}
+	nonRedundant, ok := shared.BoolFromQuery(w, r, "non_redundant")
+	if !ok {
+		return
+	}
+	rawMinParticipants, minParticipants, ok := shared.UintFromQuery(w, r, "min_participants", false)
+	if !ok {
+		return
+	}
+	rawMaxParticipants, maxParticipants, ok := shared.UintFromQuery(w, r, "max_participants", false)
 	if !ok {
 		return
 	}
+	if rawMinParticipants != "" && rawMaxParticipants != "" && minParticipants > maxParticipants {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("min_participants %d is greater than max_participants %d", minParticipants, maxParticipants),
+			http.StatusBadRequest,
+		)
+		return
+	}
 
 	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
 		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if !validateCommitteeIndices(ctx, w, headState, rawSlot, slot, rawEpoch, epoch, committeeIndices) {
+		return
+	}
 
 	attestations := s.AttestationsPool.AggregatedAttestations()
 	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
@@ -111,39 +287,198 @@ func (s *Server) ListAttestationsV2(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	aggregatedCount := len(attestations)
 	attestations = append(attestations, unaggAtts...)
+	w.Header().Set(api.PoolSizeHeader, strconv.Itoa(len(attestations)))
+	attestations, err = dedupAttestations(attestations)
+	if err != nil {
+		httputil.HandleError(w, "Could not deduplicate attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	matchesFilters := func(att eth.Att) bool {
+		return shouldIncludeAttestation(att, rawSlot, slot, committeeIndices, ffgEpochs) &&
+			(!singletonOnly || isSingletonAttestation(att)) &&
+			(rawSinceSlot == "" || att.GetData().Slot > primitives.Slot(sinceSlot)) &&
+			(rawEpoch == "" || slots.ToEpoch(att.GetData().Slot) == epoch) &&
+			(rawMinParticipants == "" || att.GetAggregationBits().Count() >= minParticipants) &&
+			(rawMaxParticipants == "" || att.GetAggregationBits().Count() <= maxParticipants)
+	}
+	if nonRedundant {
+		matching := make([]eth.Att, 0, len(attestations))
+		for _, att := range attestations {
+			if matchesFilters(att) {
+				matching = append(matching, att)
+			}
+		}
+		attestations, err = nonRedundantAttestations(matching)
+		if err != nil {
+			httputil.HandleError(w, "Could not select non-redundant attestations: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
 
+	isElectra := headState.Version() >= version.Electra
 	filteredAtts := make([]interface{}, 0, len(attestations))
 	for _, att := range attestations {
-		var includeAttestation bool
-		if headState.Version() >= version.Electra {
-			attElectra, ok := att.(*eth.AttestationElectra)
-			if !ok {
-				httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
-				return
-			}
+		if _, ok := att.(*eth.AttestationElectra); ok != isElectra {
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
+			return
+		}
+		if !matchesFilters(att) {
+			continue
+		}
+		item, err := s.listedAttestation(att, includeSSZ, includeSource)
+		if err != nil {
+			httputil.HandleError(w, "Could not convert attestation: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		filteredAtts = append(filteredAtts, item)
+	}
 
-			includeAttestation = shouldIncludeAttestation(attElectra.GetData(), rawSlot, slot, rawCommitteeIndex, committeeIndex)
-			if includeAttestation {
-				attStruct := structs.AttElectraFromConsensus(attElectra)
-				filteredAtts = append(filteredAtts, attStruct)
-			}
-		} else {
-			attOld, ok := att.(*eth.Attestation)
-			if !ok {
-				httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
-				return
-			}
+	total := len(filteredAtts)
+	recordListedAttestations(version.String(headState.Version()), aggregatedCount, len(unaggAtts), total)
+	attsData, err := s.marshalListData(w, paginateListData(filteredAtts, rawLimit, limit, offset), total)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	headSlot, headRoot, err := headStatePosition(ctx, headState)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head position: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
-			includeAttestation = shouldIncludeAttestation(attOld.GetData(), rawSlot, slot, rawCommitteeIndex, committeeIndex)
-			if includeAttestation {
-				attStruct := structs.AttFromConsensus(attOld)
-				filteredAtts = append(filteredAtts, attStruct)
-			}
+	httputil.WriteJson(w, &structs.ListAttestationsResponse{
+		Version:  version.String(headState.Version()),
+		HeadSlot: headSlot,
+		HeadRoot: headRoot,
+		Total:    strconv.Itoa(total),
+		Data:     attsData,
+	})
+}
+
+// listedAttestation converts a pooled attestation to the item returned by the attestation listing endpoints.
+// The attestation is wrapped together with its base64-encoded SSZ serialization and its pool source when requested.
+func (s *Server) listedAttestation(att eth.Att, includeSSZ, includeSource bool) (interface{}, error) {
+	var encoded, source string
+	if includeSSZ {
+		sszBytes, err := att.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal attestation to SSZ")
+		}
+		encoded = base64.StdEncoding.EncodeToString(sszBytes)
+	}
+	if includeSource {
+		source = s.AttestationsPool.AttestationSource(att)
+	}
+	wrap := includeSSZ || includeSource
+
+	switch a := att.(type) {
+	case *eth.Attestation:
+		if !wrap {
+			return structs.AttFromConsensus(a), nil
+		}
+		return &structs.AttestationWithSSZ{Attestation: structs.AttFromConsensus(a), SSZ: encoded, Source: source}, nil
+	case *eth.AttestationElectra:
+		if !wrap {
+			return structs.AttElectraFromConsensus(a), nil
+		}
+		return &structs.AttestationElectraWithSSZ{AttestationElectra: structs.AttElectraFromConsensus(a), SSZ: encoded, Source: source}, nil
+	default:
+		return nil, fmt.Errorf("unable to convert attestation of type %T", att)
+	}
+}
+
+// nonRedundantAttestations greedily selects, for every attestation data, the attestations whose aggregation bits add
+// coverage to the attestations selected before them. Attestations with more aggregation bits set are visited first,
+// so that the selection stays small. Electra attestations are only compared to attestations with the same committee bits.
+// The selected attestations are returned in their input order.
+func nonRedundantAttestations(atts []eth.Att) ([]eth.Att, error) {
+	order := make([]int, len(atts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return atts[order[i]].GetAggregationBits().Count() > atts[order[j]].GetAggregationBits().Count()
+	})
+
+	coverage := make(map[string]bitfield.Bitlist)
+	selected := make([]bool, len(atts))
+	for _, i := range order {
+		att := atts[i]
+		key, err := attestationDataKey(att)
+		if err != nil {
+			return nil, err
+		}
+		bits := att.GetAggregationBits()
+		covered, ok := coverage[key]
+		if !ok {
+			coverage[key] = bitfield.Bitlist(bytesutil.SafeCopyBytes(bits))
+			selected[i] = true
+			continue
+		}
+		if covered.Len() != bits.Len() {
+			// Attestations with mismatching bitlist lengths cannot be compared, so they are kept.
+			selected[i] = true
+			continue
+		}
+		contained, err := covered.Contains(bits)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compare aggregation bits")
+		}
+		if contained {
+			continue
+		}
+		if coverage[key], err = covered.Or(bits); err != nil {
+			return nil, errors.Wrap(err, "could not merge aggregation bits")
+		}
+		selected[i] = true
+	}
+
+	result := make([]eth.Att, 0, len(atts))
+	for i, att := range atts {
+		if selected[i] {
+			result = append(result, att)
+		}
+	}
+	return result, nil
+}
+
+// ListAttestationsByAggregator retrieves aggregates that the node received through the aggregate-and-proof path
+// from the aggregator identified by the `aggregator_index` query parameter.
+// Aggregates are retained for two epochs after they are received.
+func (s *Server) ListAttestationsByAggregator(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.ListAttestationsByAggregator")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	_, aggregatorIndex, ok := shared.UintFromQuery(w, r, "aggregator_index", true)
+	if !ok {
+		return
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	aggregates := s.AttestationsPool.AggregatorAttestations(primitives.ValidatorIndex(aggregatorIndex))
+	result := make([]interface{}, 0, len(aggregates))
+	for _, agg := range aggregates {
+		switch a := agg.(type) {
+		case *eth.Attestation:
+			result = append(result, structs.AttFromConsensus(a))
+		case *eth.AttestationElectra:
+			result = append(result, structs.AttElectraFromConsensus(a))
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", agg), http.StatusInternalServerError)
+			return
 		}
 	}
 
-	attsData, err := json.Marshal(filteredAtts)
+	attsData, err := s.marshalListData(w, result, len(result))
 	if err != nil {
 		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -155,365 +490,3119 @@ func (s *Server) ListAttestationsV2(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// Helper function to determine if an attestation should be included
-func shouldIncludeAttestation(
-	data *eth.AttestationData,
-	rawSlot string,
-	slot uint64,
-	rawCommitteeIndex string,
-	committeeIndex uint64,
-) bool {
-	committeeIndexMatch := true
-	slotMatch := true
-	if rawCommitteeIndex != "" && data.CommitteeIndex != primitives.CommitteeIndex(committeeIndex) {
-		committeeIndexMatch = false
+// ListAttestationsByValidator retrieves pooled attestations in which the validator identified by the
+// `validator_index` query parameter participated. Participation is determined by computing the attesting
+// committees of every pooled attestation from the head state and checking the validator's aggregation bit.
+// Committee computation makes this endpoint considerably more expensive than ListAttestationsV2,
+// so it should not be polled frequently. Only attestations in the format of the head state's fork are returned,
+// and attestations whose committees cannot be computed are skipped.
+func (s *Server) ListAttestationsByValidator(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.ListAttestationsByValidator")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	_, validatorIndex, ok := shared.UintFromQuery(w, r, "validator_index", true)
+	if !ok {
+		return
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	isElectra := headState.Version() >= version.Electra
+	// Committees are computed once for every slot that pooled attestations refer to.
+	committeesBySlot := make(map[primitives.Slot][][]primitives.ValidatorIndex)
+	filteredAtts := make([]interface{}, 0)
+	for _, att := range attestations {
+		if (att.Version() >= version.Electra) != isElectra {
+			continue
+		}
+		attSlot := att.GetData().Slot
+		slotCommittees, ok := committeesBySlot[attSlot]
+		if !ok {
+			slotCommittees, err = corehelpers.BeaconCommittees(ctx, headState, attSlot)
+			if err != nil {
+				log.WithError(err).WithField("slot", attSlot).Debug("Could not get committees of pooled attestations")
+			}
+			committeesBySlot[attSlot] = slotCommittees
+		}
+		if slotCommittees == nil {
+			continue
+		}
+		committees, err := attestationCommitteesFromSlot(att, slotCommittees)
+		if err != nil {
+			log.WithError(err).WithField("slot", attSlot).Debug("Skipping pooled attestation")
+			continue
+		}
+		attestingIndices, err := attestation.AttestingIndices(att, committees...)
+		if err != nil {
+			log.WithError(err).WithField("slot", attSlot).Debug("Skipping pooled attestation")
+			continue
+		}
+		if !slice.IsInUint64(validatorIndex, attestingIndices) {
+			continue
+		}
+		item, err := s.listedAttestation(att, false, false)
+		if err != nil {
+			httputil.HandleError(w, "Could not convert attestation: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		filteredAtts = append(filteredAtts, item)
 	}
-	if rawSlot != "" && data.Slot != primitives.Slot(slot) {
-		slotMatch = false
+
+	attsData, err := s.marshalListData(w, filteredAtts, len(filteredAtts))
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
-	return committeeIndexMatch && slotMatch
+
+	httputil.WriteJson(w, &structs.ListAttestationsResponse{
+		Version: version.String(headState.Version()),
+		Data:    attsData,
+	})
 }
 
-// SubmitAttestations submits an attestation object to node. If the attestation passes all validation
-// constraints, node MUST publish the attestation on an appropriate subnet.
-func (s *Server) SubmitAttestations(w http.ResponseWriter, r *http.Request) {
-	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestations")
+// GetAttestationPoolDiff returns the attestations added to and removed from the pool since the snapshot
+// identified by the `token` query parameter. Every response carries a new token that can be passed in the
+// next request. When no token is provided, all pooled attestations are reported as added.
+// Removed attestations are identified by their hash tree roots. Snapshots are only retained for a limited time,
+// and no token is returned when the pool is too large to be snapshotted.
+func (s *Server) GetAttestationPoolDiff(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetAttestationPoolDiff")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
-	var req structs.SubmitAttestationsRequest
-	err := json.NewDecoder(r.Body).Decode(&req.Data)
-	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+	var previous attestationPoolSnapshot
+	if token := r.URL.Query().Get("token"); token != "" {
+		var ok bool
+		previous, ok = s.AttestationPoolSnapshots.Get(token)
+		if !ok {
+			httputil.HandleError(w, "Snapshot token is unknown or has expired", http.StatusNotFound)
+			return
+		}
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	attFailures, failedBroadcasts, err := s.handleAttestations(ctx, req.Data)
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
 	if err != nil {
-		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	current := make(map[[32]byte]struct{}, len(attestations))
+	added := make([]interface{}, 0)
+	for _, att := range attestations {
+		root, err := att.HashTreeRoot()
+		if err != nil {
+			httputil.HandleError(w, "Could not compute attestation root: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if _, ok := current[root]; ok {
+			continue
+		}
+		current[root] = struct{}{}
+		if previous.Contains(root) {
+			continue
+		}
+		switch a := att.(type) {
+		case *eth.Attestation:
+			added = append(added, structs.AttFromConsensus(a))
+		case *eth.AttestationElectra:
+			added = append(added, structs.AttElectraFromConsensus(a))
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
+			return
+		}
+	}
+	// The snapshot is sorted, so removed roots are reported in order.
+	removed := make([]string, 0)
+	for _, root := range previous {
+		if _, ok := current[root]; !ok {
+			removed = append(removed, hexutil.Encode(root[:]))
+		}
+	}
+	currentRoots := make([][32]byte, 0, len(current))
+	for root := range current {
+		currentRoots = append(currentRoots, root)
+	}
+
+	addedData, err := json.Marshal(added)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httputil.WriteJson(w, &structs.AttestationPoolDiffResponse{
+		Version: version.String(headState.Version()),
+		Token:   s.AttestationPoolSnapshots.Save(currentRoots),
+		Added:   addedData,
+		Removed: removed,
+	})
+}
+
+// ListAttestationVotesByBlockRoot groups pooled attestations by the beacon block root they vote for and returns,
+// for every root, the number of attestations and the sum of their aggregation bit counts.
+// Overlapping attestations are not deduplicated, so the participant count is an upper bound of distinct attesters.
+// Roots are ordered by descending participant count.
+func (s *Server) ListAttestationVotesByBlockRoot(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.ListAttestationVotesByBlockRoot")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	type votes struct {
+		root         [32]byte
+		participants uint64
+		attestations uint64
+	}
+	votesByRoot := make(map[[32]byte]*votes)
+	for _, att := range attestations {
+		root := bytesutil.ToBytes32(att.GetData().BeaconBlockRoot)
+		v, ok := votesByRoot[root]
+		if !ok {
+			v = &votes{root: root}
+			votesByRoot[root] = v
+		}
+		v.participants += att.GetAggregationBits().Count()
+		v.attestations++
+	}
+
+	sorted := make([]*votes, 0, len(votesByRoot))
+	for _, v := range votesByRoot {
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].participants != sorted[j].participants {
+			return sorted[i].participants > sorted[j].participants
+		}
+		return bytes.Compare(sorted[i].root[:], sorted[j].root[:]) < 0
+	})
+
+	data := make([]*structs.AttestationBlockRootVotes, len(sorted))
+	for i, v := range sorted {
+		data[i] = &structs.AttestationBlockRootVotes{
+			BlockRoot:        hexutil.Encode(v.root[:]),
+			ParticipantCount: strconv.FormatUint(v.participants, 10),
+			AttestationCount: strconv.FormatUint(v.attestations, 10),
+		}
+	}
+	httputil.WriteJson(w, &structs.ListAttestationVotesByBlockRootResponse{Data: data})
+}
+
+// GetBestAttestation returns the pooled aggregated attestation with the most participants among those
+// for the slot and committee given by the `slot` and `committee_index` query parameters.
+// Unaggregated attestations are not considered. A 404 is returned when no aggregate matches.
+func (s *Server) GetBestAttestation(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetBestAttestation")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	_, slot, ok := shared.UintFromQuery(w, r, "slot", true)
+	if !ok {
+		return
+	}
+	_, committeeIndex, ok := shared.UintFromQuery(w, r, "committee_index", true)
+	if !ok {
+		return
+	}
+
+	var best eth.Att
+	for _, att := range s.AttestationsPool.AggregatedAttestations() {
+		if att.GetData().Slot != primitives.Slot(slot) || !attestationInCommittee(att, primitives.CommitteeIndex(committeeIndex)) {
+			continue
+		}
+		if best == nil || att.GetAggregationBits().Count() > best.GetAggregationBits().Count() {
+			best = att
+		}
+	}
+	if best == nil {
+		httputil.HandleError(w, "No matching aggregated attestation found", http.StatusNotFound)
+		return
+	}
+
+	var attStruct interface{}
+	switch a := best.(type) {
+	case *eth.Attestation:
+		attStruct = structs.AttFromConsensus(a)
+	case *eth.AttestationElectra:
+		attStruct = structs.AttElectraFromConsensus(a)
+	default:
+		httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", best), http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(attStruct)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestation: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetBestAttestationResponse{
+		Version: version.String(best.Version()),
+		Data:    data,
+	})
+}
+
+// GetPoolAttestation retrieves the pooled attestation whose data has the hash tree root given by the
+// `attestation_data_root` path parameter. Both aggregated and unaggregated attestations are searched. When several
+// attestations share the same data, the one with the most aggregation bits set is returned. Attestations are
+// serialized in the format of their own fork, so Electra attestations carry their committee bits.
+func (s *Server) GetPoolAttestation(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetPoolAttestation")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	_, rootBytes, ok := shared.HexFromRoute(w, r, "attestation_data_root", fieldparams.RootLength)
+	if !ok {
+		return
+	}
+	root := bytesutil.ToBytes32(rootBytes)
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+	attestations, err = dedupAttestations(attestations)
+	if err != nil {
+		httputil.HandleError(w, "Could not deduplicate attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var found eth.Att
+	for _, att := range attestations {
+		dataRoot, err := att.GetData().HashTreeRoot()
+		if err != nil {
+			httputil.HandleError(w, "Could not compute attestation data root: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if dataRoot != root {
+			continue
+		}
+		if found == nil || att.GetAggregationBits().Count() > found.GetAggregationBits().Count() {
+			found = att
+		}
+	}
+	if found == nil {
+		httputil.HandleError(w, fmt.Sprintf("No pooled attestation found with data root %#x", root), http.StatusNotFound)
+		return
+	}
+
+	var attStruct interface{}
+	switch a := found.(type) {
+	case *eth.Attestation:
+		attStruct = structs.AttFromConsensus(a)
+	case *eth.AttestationElectra:
+		attStruct = structs.AttElectraFromConsensus(a)
+	default:
+		httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", found), http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(attStruct)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestation: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPoolAttestationResponse{
+		Version: version.String(found.Version()),
+		Data:    data,
+	})
+}
+
+// ListPrunableAttestations retrieves the pooled attestations that are due to be removed by the next pruning
+// of the attestation pool, without removing them. Attestations are selected with the same age criteria as the pruner.
+// Attestations that were only retained because they were seen in blocks are not reported.
+func (s *Server) ListPrunableAttestations(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.ListPrunableAttestations")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	pooledAtts := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pooledAtts = append(pooledAtts, unaggAtts...)
+
+	genesisTime := uint64(s.GenesisTimeFetcher.GenesisTime().Unix())
+	prunable := make([]interface{}, 0)
+	for _, att := range pooledAtts {
+		if !attestations.Expired(genesisTime, att.GetData().Slot) {
+			continue
+		}
+		switch a := att.(type) {
+		case *eth.Attestation:
+			prunable = append(prunable, structs.AttFromConsensus(a))
+		case *eth.AttestationElectra:
+			prunable = append(prunable, structs.AttElectraFromConsensus(a))
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	attsData, err := s.marshalListData(w, prunable, len(prunable))
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.ListAttestationsResponse{Data: attsData})
+}
+
+// GetValidatorPoolStatus reports what the operation pools hold about the validator identified by the
+// `validator_index` query parameter: whether it has a pending voluntary exit or BLS to execution change,
+// and whether it is slashable by a pending attester or proposer slashing.
+// When `include_attestations=true` is passed, the response also reports whether the validator participated
+// in any pooled attestation. This requires computing attestation committees, which makes the request
+// considerably more expensive. Committees are computed once per slot, and attestations whose committees cannot
+// be computed from the head state are ignored.
+func (s *Server) GetValidatorPoolStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetValidatorPoolStatus")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	rawValidatorIndex, validatorIndex, ok := shared.UintFromQuery(w, r, "validator_index", true)
+	if !ok {
+		return
+	}
+	includeAttestations, ok := shared.BoolFromQuery(w, r, "include_attestations")
+	if !ok {
+		return
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	status := &structs.ValidatorPoolStatus{ValidatorIndex: rawValidatorIndex}
+	exits, err := s.VoluntaryExitsPool.PendingExits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get exits from the pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, exit := range exits {
+		if uint64(exit.Exit.ValidatorIndex) == validatorIndex {
+			status.PendingVoluntaryExit = true
+			break
+		}
+	}
+	changes, err := s.BLSChangesPool.PendingBLSToExecChanges()
+	if err != nil {
+		httputil.HandleError(w, "Could not get BLS to execution changes from the pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, change := range changes {
+		if uint64(change.Message.ValidatorIndex) == validatorIndex {
+			status.PendingBLSToExecutionChange = true
+			break
+		}
+	}
+	for _, slashing := range s.SlashingsPool.PendingAttesterSlashings(ctx, headState, true /* no limit */) {
+		slashable := slice.IntersectionUint64(
+			slashing.FirstAttestation().GetAttestingIndices(),
+			slashing.SecondAttestation().GetAttestingIndices(),
+		)
+		if slice.IsInUint64(validatorIndex, slashable) {
+			status.PendingAttesterSlashing = true
+			break
+		}
+	}
+	for _, slashing := range s.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* no limit */) {
+		if uint64(slashing.Header_1.Header.ProposerIndex) == validatorIndex {
+			status.PendingProposerSlashing = true
+			break
+		}
+	}
+
+	if includeAttestations {
+		pooledAtts := s.AttestationsPool.AggregatedAttestations()
+		unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+		if err != nil {
+			httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		pooledAtts = append(pooledAtts, unaggAtts...)
+
+		participated := false
+		committeesBySlot := make(map[primitives.Slot][][]primitives.ValidatorIndex)
+		for _, att := range pooledAtts {
+			slot := att.GetData().Slot
+			slotCommittees, ok := committeesBySlot[slot]
+			if !ok {
+				slotCommittees, err = corehelpers.BeaconCommittees(ctx, headState, slot)
+				if err != nil {
+					log.WithError(err).WithField("slot", slot).Debug("Could not get committees of pooled attestations")
+				}
+				committeesBySlot[slot] = slotCommittees
+			}
+			if slotCommittees == nil {
+				continue
+			}
+			committees, err := attestationCommitteesFromSlot(att, slotCommittees)
+			if err != nil {
+				log.WithError(err).WithField("slot", slot).Debug("Skipping pooled attestation")
+				continue
+			}
+			attestingIndices, err := attestation.AttestingIndices(att, committees...)
+			if err != nil {
+				log.WithError(err).WithField("slot", slot).Debug("Skipping pooled attestation")
+				continue
+			}
+			if slice.IsInUint64(validatorIndex, attestingIndices) {
+				participated = true
+				break
+			}
+		}
+		status.PooledAttestation = &participated
+	}
+
+	httputil.WriteJson(w, &structs.GetValidatorPoolStatusResponse{Data: status})
+}
+
+// GetAttestationPoolFormatCounts returns the number of pooled attestations in the pre-Electra and in the Electra format.
+// Both formats can be pooled at the same time around the Electra fork boundary.
+func (s *Server) GetAttestationPoolFormatCounts(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetAttestationPoolFormatCounts")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	var preElectra, electra int
+	for _, att := range attestations {
+		switch att.(type) {
+		case *eth.Attestation:
+			preElectra++
+		case *eth.AttestationElectra:
+			electra++
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	httputil.WriteJson(w, &structs.GetAttestationPoolFormatCountsResponse{
+		Data: &structs.AttestationPoolFormatCounts{
+			PreElectra: strconv.Itoa(preElectra),
+			Electra:    strconv.Itoa(electra),
+		},
+	})
+}
+
+// GetPoolStats returns the number of objects held by each operation pool.
+// Only the sizes of the pools are read, the pooled objects themselves are neither copied nor marshaled.
+func (s *Server) GetPoolStats(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetPoolStats")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	attSlashings, propSlashings := s.SlashingsPool.PendingSlashingCounts()
+	httputil.WriteJson(w, &structs.GetPoolStatsResponse{
+		Data: &structs.PoolStats{
+			AggregatedAttestations:   strconv.Itoa(s.AttestationsPool.AggregatedAttestationCount()),
+			UnaggregatedAttestations: strconv.Itoa(s.AttestationsPool.UnaggregatedAttestationCount()),
+			VoluntaryExits:           strconv.Itoa(s.VoluntaryExitsPool.PendingExitCount()),
+			AttesterSlashings:        strconv.Itoa(attSlashings),
+			ProposerSlashings:        strconv.Itoa(propSlashings),
+			BLSToExecutionChanges:    strconv.Itoa(s.BLSChangesPool.PendingBLSToExecChangeCount()),
+		},
+	})
+}
+
+// GetAttestationPoolSlotHistogram returns the number of pooled attestations for every slot of a recent window,
+// which ends at the current slot. Slots without attestations are reported with a count of 0, so that gaps stand out.
+// The window spans two epochs unless the `window` query parameter gives the number of slots, up to four epochs.
+func (s *Server) GetAttestationPoolSlotHistogram(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetAttestationPoolSlotHistogram")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	rawWindow, window, ok := shared.UintFromQuery(w, r, "window", false)
+	if !ok {
+		return
+	}
+	if rawWindow == "" {
+		window = 2 * slotsPerEpoch
+	}
+	if window == 0 || window > 4*slotsPerEpoch {
+		httputil.HandleError(w, fmt.Sprintf("window must be between 1 and %d slots", 4*slotsPerEpoch), http.StatusBadRequest)
+		return
+	}
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	currentSlot := s.GenesisTimeFetcher.CurrentSlot()
+	startSlot := primitives.Slot(0)
+	if uint64(currentSlot)+1 > window {
+		startSlot = currentSlot + 1 - primitives.Slot(window)
+	}
+	counts := make(map[primitives.Slot]int)
+	for slot := startSlot; slot <= currentSlot; slot++ {
+		counts[slot] = 0
+	}
+	for _, att := range attestations {
+		if _, ok := counts[att.GetData().Slot]; ok {
+			counts[att.GetData().Slot]++
+		}
+	}
+
+	data := make(map[string]string, len(counts))
+	for slot, count := range counts {
+		data[strconv.FormatUint(uint64(slot), 10)] = strconv.Itoa(count)
+	}
+	httputil.WriteJson(w, &structs.GetAttestationPoolSlotHistogramResponse{Data: data})
+}
+
+// GetAttestationPoolAge returns the slot of the oldest pooled attestation along with its age relative to the current slot,
+// both in slots and in seconds. It is meant to help calibrate the pruning of the pool. A 404 is returned when the pool is empty.
+func (s *Server) GetAttestationPoolAge(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetAttestationPoolAge")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+	if len(attestations) == 0 {
+		httputil.HandleError(w, "Attestation pool is empty", http.StatusNotFound)
+		return
+	}
+
+	oldest := attestations[0].GetData().Slot
+	for _, att := range attestations[1:] {
+		if att.GetData().Slot < oldest {
+			oldest = att.GetData().Slot
+		}
+	}
+	// Attestations for a future slot can be pooled when the clock of the node lags behind, they have no age.
+	var age primitives.Slot
+	if currentSlot := s.GenesisTimeFetcher.CurrentSlot(); currentSlot > oldest {
+		age = currentSlot - oldest
+	}
+
+	httputil.WriteJson(w, &structs.GetAttestationPoolAgeResponse{
+		Data: &structs.AttestationPoolAge{
+			OldestSlot: strconv.FormatUint(uint64(oldest), 10),
+			AgeSlots:   strconv.FormatUint(uint64(age), 10),
+			AgeSeconds: strconv.FormatUint(uint64(age)*params.BeaconConfig().SecondsPerSlot, 10),
+		},
+	})
+}
+
+// GetAttestationPoolValidatorCount returns the number of distinct validators that participated in at least one
+// pooled attestation. Attesting validators are determined by computing the committees of every pooled attestation
+// from the head state. Committees are computed once per slot and reused for all attestations of that slot within
+// a request, but the computation still makes this endpoint considerably more expensive than plain pool listings,
+// so it should not be polled frequently. Attestations whose committees cannot be computed from the head state are not counted.
+func (s *Server) GetAttestationPoolValidatorCount(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetAttestationPoolValidatorCount")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	committeesBySlot := make(map[primitives.Slot][][]primitives.ValidatorIndex)
+	validators := make(map[uint64]struct{})
+	for _, att := range attestations {
+		slot := att.GetData().Slot
+		slotCommittees, ok := committeesBySlot[slot]
+		if !ok {
+			slotCommittees, err = corehelpers.BeaconCommittees(ctx, headState, slot)
+			if err != nil {
+				log.WithError(err).WithField("slot", slot).Debug("Could not get committees of pooled attestations")
+			}
+			committeesBySlot[slot] = slotCommittees
+		}
+		if slotCommittees == nil {
+			continue
+		}
+		committees, err := attestationCommitteesFromSlot(att, slotCommittees)
+		if err != nil {
+			log.WithError(err).WithField("slot", slot).Debug("Skipping pooled attestation")
+			continue
+		}
+		attestingIndices, err := attestation.AttestingIndices(att, committees...)
+		if err != nil {
+			log.WithError(err).WithField("slot", slot).Debug("Skipping pooled attestation")
+			continue
+		}
+		for _, idx := range attestingIndices {
+			validators[idx] = struct{}{}
+		}
+	}
+
+	httputil.WriteJson(w, &structs.GetAttestationPoolValidatorCountResponse{
+		Data: &structs.AttestationPoolValidatorCount{
+			ValidatorCount: strconv.Itoa(len(validators)),
+		},
+	})
+}
+
+// attestationCommitteesFromSlot selects the committees that the attestation votes for among all committees of its slot.
+func attestationCommitteesFromSlot(att eth.Att, slotCommittees [][]primitives.ValidatorIndex) ([][]primitives.ValidatorIndex, error) {
+	var committeeIndices []int
+	if att.Version() >= version.Electra {
+		committeeIndices = att.CommitteeBitsVal().BitIndices()
+	} else {
+		committeeIndices = []int{int(att.GetData().CommitteeIndex)}
+	}
+	committees := make([][]primitives.ValidatorIndex, len(committeeIndices))
+	for i, ci := range committeeIndices {
+		if ci >= len(slotCommittees) {
+			return nil, fmt.Errorf("committee index %d is out of range, slot has %d committees", ci, len(slotCommittees))
+		}
+		committees[i] = slotCommittees[ci]
+	}
+	return committees, nil
+}
+
+// GetAttestationPoolCoverage returns, for every committee of the slot given by the `slot` query parameter,
+// the union of the aggregation bits of all pooled attestations for that committee. A bit that is not set
+// identifies a committee member whose vote is not held by the pool. Committees are computed from the head state.
+func (s *Server) GetAttestationPoolCoverage(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetAttestationPoolCoverage")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	_, rawSlot, ok := shared.UintFromQuery(w, r, "slot", true)
+	if !ok {
+		return
+	}
+	slot := primitives.Slot(rawSlot)
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	slotCommittees, err := corehelpers.BeaconCommittees(ctx, headState, slot)
+	if err != nil {
+		httputil.HandleError(w, "Could not get beacon committees: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	coverage := make([]bitfield.Bitlist, len(slotCommittees))
+	for i, committee := range slotCommittees {
+		coverage[i] = bitfield.NewBitlist(uint64(len(committee)))
+	}
+	for _, att := range attestations {
+		if att.GetData().Slot != slot {
+			continue
+		}
+		if err = addAttestationCoverage(coverage, att); err != nil {
+			httputil.HandleError(w, "Could not compute attestation coverage: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	data := make([]*structs.CommitteeCoverage, len(coverage))
+	for i, bits := range coverage {
+		data[i] = &structs.CommitteeCoverage{
+			CommitteeIndex: strconv.Itoa(i),
+			CommitteeSize:  strconv.FormatUint(bits.Len(), 10),
+			Covered:        strconv.FormatUint(bits.Count(), 10),
+			Coverage:       hexutil.Encode(bits),
+		}
+	}
+	httputil.WriteJson(w, &structs.GetAttestationPoolCoverageResponse{Data: data})
+}
+
+// addAttestationCoverage ORs the aggregation bits of the attestation into the coverage of the committees it votes for.
+// The aggregation bits of Electra attestations span all of their committees, ordered by committee index.
+func addAttestationCoverage(coverage []bitfield.Bitlist, att eth.Att) error {
+	var committeeIndices []int
+	if att.Version() >= version.Electra {
+		committeeIndices = att.CommitteeBitsVal().BitIndices()
+	} else {
+		committeeIndices = []int{int(att.GetData().CommitteeIndex)}
+	}
+	bits := att.GetAggregationBits()
+	var size uint64
+	for _, ci := range committeeIndices {
+		if ci >= len(coverage) {
+			return fmt.Errorf("committee index %d is out of range, slot has %d committees", ci, len(coverage))
+		}
+		size += coverage[ci].Len()
+	}
+	if bits.Len() != size {
+		return fmt.Errorf("aggregation bits length %d does not match committees size %d", bits.Len(), size)
+	}
+	var offset uint64
+	for _, ci := range committeeIndices {
+		for i := uint64(0); i < coverage[ci].Len(); i++ {
+			if bits.BitAt(offset + i) {
+				coverage[ci].SetBitAt(i, true)
+			}
+		}
+		offset += coverage[ci].Len()
+	}
+	return nil
+}
+
+// GetAttestationsForInclusion returns the attestations that the node would include in a block proposed at the slot
+// passed in the `slot` query parameter, which defaults to the slot following the current slot and cannot be later
+// than it. The attestations are selected from the current pool and head state by the same logic that is used when
+// building a block. The pool is left untouched, invalid attestations are only left out of the response.
+func (s *Server) GetAttestationsForInclusion(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetAttestationsForInclusion")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	rawSlot, slotValue, ok := shared.UintFromQuery(w, r, "slot", false)
+	if !ok {
+		return
+	}
+	nextSlot := s.GenesisTimeFetcher.CurrentSlot() + 1
+	slot := nextSlot
+	if rawSlot != "" {
+		slot = primitives.Slot(slotValue)
+	}
+	// The head state is advanced to the requested slot, so slots far ahead would be expensive to process.
+	if slot > nextSlot {
+		httputil.HandleError(w, fmt.Sprintf("Slot %d is after the next slot %d", slot, nextSlot), http.StatusBadRequest)
+		return
+	}
+	if headSlot := s.ChainInfoFetcher.HeadSlot(); slot <= headSlot {
+		httputil.HandleError(w, fmt.Sprintf("Slot %d is not after the head slot %d", slot, headSlot), http.StatusBadRequest)
+		return
+	}
+
+	atts, err := s.AttestationPacker.PackAttestations(ctx, slot)
+	if err != nil {
+		httputil.HandleError(w, "Could not pack attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attStructs := make([]interface{}, len(atts))
+	for i, att := range atts {
+		switch a := att.(type) {
+		case *eth.Attestation:
+			attStructs[i] = structs.AttFromConsensus(a)
+		case *eth.AttestationElectra:
+			attStructs[i] = structs.AttElectraFromConsensus(a)
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
+			return
+		}
+	}
+	data, err := json.Marshal(attStructs)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// The version identifies the format of the attestations, which only changes at the Electra fork.
+	attVersion := version.Phase0
+	if slots.ToEpoch(slot) >= params.BeaconConfig().ElectraForkEpoch {
+		attVersion = version.Electra
+	}
+	httputil.WriteJson(w, &structs.GetAttestationsForInclusionResponse{
+		Version: version.String(attVersion),
+		Slot:    strconv.FormatUint(uint64(slot), 10),
+		Data:    data,
+	})
+}
+
+// GetEligibleAttestations returns the pooled attestations that can be included in a block at the slot passed in
+// the required `target_slot` query parameter, sorted by decreasing number of participants. An attestation is eligible
+// when at least MIN_ATTESTATION_INCLUSION_DELAY slots have passed since its slot and it is still within the inclusion
+// window of the fork of the target slot. The format of the attestations must also match the fork of the target slot,
+// so only Electra attestations are eligible for an Electra block and only pre-Electra attestations otherwise.
+// Unlike GetAttestationsForInclusion, attestations are neither verified against the head state nor aggregated.
+func (s *Server) GetEligibleAttestations(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetEligibleAttestations")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	_, targetSlotValue, ok := shared.UintFromQuery(w, r, "target_slot", true)
+	if !ok {
+		return
+	}
+	targetSlot := primitives.Slot(targetSlotValue)
+
+	attestations := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attestations = append(attestations, unaggAtts...)
+
+	// The version identifies the format of the attestations, which only changes at the Electra fork.
+	attVersion := version.Phase0
+	if slots.ToEpoch(targetSlot) >= params.BeaconConfig().ElectraForkEpoch {
+		attVersion = version.Electra
+	}
+	eligible := make([]eth.Att, 0, len(attestations))
+	for _, att := range attestations {
+		if (att.Version() >= version.Electra) == (attVersion >= version.Electra) && attestationIncludableAt(att.GetData().Slot, targetSlot) {
+			eligible = append(eligible, att)
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return eligible[i].GetAggregationBits().Count() > eligible[j].GetAggregationBits().Count()
+	})
+
+	attStructs := make([]interface{}, len(eligible))
+	for i, att := range eligible {
+		switch a := att.(type) {
+		case *eth.Attestation:
+			attStructs[i] = structs.AttFromConsensus(a)
+		case *eth.AttestationElectra:
+			attStructs[i] = structs.AttElectraFromConsensus(a)
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
+			return
+		}
+	}
+	data, err := json.Marshal(attStructs)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetEligibleAttestationsResponse{
+		Version:    version.String(attVersion),
+		TargetSlot: strconv.FormatUint(uint64(targetSlot), 10),
+		Data:       data,
+	})
+}
+
+// attestationIncludableAt reports whether an attestation with the given slot can be included in a block at the target slot.
+// The inclusion window depends on the fork of the target slot. Before Deneb, it ends SLOTS_PER_EPOCH slots after
+// the attestation slot. Starting with Deneb (EIP-7045), it ends with the epoch following the attestation epoch.
+func attestationIncludableAt(attSlot, targetSlot primitives.Slot) bool {
+	cfg := params.BeaconConfig()
+	if attSlot+cfg.MinAttestationInclusionDelay > targetSlot {
+		return false
+	}
+	targetEpoch := slots.ToEpoch(targetSlot)
+	if targetEpoch < cfg.DenebForkEpoch {
+		return targetSlot <= attSlot+cfg.SlotsPerEpoch
+	}
+	return slots.ToEpoch(attSlot)+1 >= targetEpoch
+}
+
+// GetSubmissionRejections retrieves the number of operations rejected by the submit handlers per rejection category
+// over a rolling window. Broadcast failures are counted alongside validation failures.
+func (s *Server) GetSubmissionRejections(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetSubmissionRejections")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	counts := s.SubmissionRejections.Counts()
+	data := make(map[string]string, len(counts))
+	for c, n := range counts {
+		data[c] = strconv.FormatUint(n, 10)
+	}
+	httputil.WriteJson(w, &structs.GetSubmissionRejectionsResponse{
+		Window: strconv.FormatInt(int64(submissionRejectionsWindow.Seconds()), 10),
+		Data:   data,
+	})
+}
+
+// ListBroadcastFailures retrieves the most recent operations that the node failed to broadcast,
+// ordered from the oldest to the most recent. Only a bounded number of failures is retained.
+func (s *Server) ListBroadcastFailures(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.ListBroadcastFailures")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	failures := s.BroadcastFailures.Recent()
+	data := make([]*structs.BroadcastFailure, len(failures))
+	for i, f := range failures {
+		data[i] = &structs.BroadcastFailure{
+			Operation: f.Operation,
+			Index:     strconv.Itoa(f.Index),
+			Root:      hexutil.Encode(f.Root[:]),
+			Error:     f.Err,
+			Timestamp: strconv.FormatInt(f.Time.Unix(), 10),
+		}
+		if f.HasSubnet {
+			data[i].Subnet = strconv.FormatUint(f.Subnet, 10)
+		}
+	}
+	httputil.WriteJson(w, &structs.ListBroadcastFailuresResponse{Data: data})
+}
+
+// marshalListData marshals the items of a listing response into a JSON array. When MaxListResponseSize is set
+// and the array would exceed it, only the longest prefix of items that fits is returned, and the truncation
+// is reported through the response headers along with the total number of items, which is the number of items
+// before pagination for paginated listings.
+func (s *Server) marshalListData(w http.ResponseWriter, items []interface{}, total int) (json.RawMessage, error) {
+	if s.MaxListResponseSize == 0 {
+		return json.Marshal(items)
+	}
+	data := []byte{'['}
+	for i, item := range items {
+		itemData, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		separator := 0
+		if i > 0 {
+			separator = 1
+		}
+		// The closing bracket has to fit as well.
+		if uint64(len(data)+separator+len(itemData)+1) > s.MaxListResponseSize {
+			w.Header().Set(api.ResponseTruncatedHeader, "true")
+			w.Header().Set(api.TotalCountHeader, strconv.Itoa(total))
+			break
+		}
+		if i > 0 {
+			data = append(data, ',')
+		}
+		data = append(data, itemData...)
+	}
+	return append(data, ']'), nil
+}
+
+// dedupAttestations removes attestations whose aggregation bits are a subset of the aggregation bits of another
+// attestation with the same data, such as exact duplicates or an unaggregated attestation that is also part of
+// a pooled aggregate. Electra attestations are only compared to attestations with the same committee bits.
+// The remaining attestations are sorted by slot, then by data root and then by aggregation bits.
+func dedupAttestations(atts []eth.Att) ([]eth.Att, error) {
+	type keyedAtt struct {
+		att eth.Att
+		key string
+	}
+	keyed := make([]keyedAtt, len(atts))
+	for i, att := range atts {
+		key, err := attestationDataKey(att)
+		if err != nil {
+			return nil, err
+		}
+		keyed[i] = keyedAtt{att: att, key: key}
+	}
+	// Attestations with more aggregation bits set are visited first, so that they are kept over their subsets.
+	sort.SliceStable(keyed, func(i, j int) bool {
+		return keyed[i].att.GetAggregationBits().Count() > keyed[j].att.GetAggregationBits().Count()
+	})
+
+	kept := make(map[string][]bitfield.Bitlist)
+	unique := make([]keyedAtt, 0, len(keyed))
+	for _, k := range keyed {
+		bits := k.att.GetAggregationBits()
+		redundant := false
+		for _, keptBits := range kept[k.key] {
+			if keptBits.Len() != bits.Len() {
+				continue
+			}
+			contained, err := keptBits.Contains(bits)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not compare aggregation bits")
+			}
+			if contained {
+				redundant = true
+				break
+			}
+		}
+		if redundant {
+			continue
+		}
+		kept[k.key] = append(kept[k.key], bits)
+		unique = append(unique, k)
+	}
+
+	sort.Slice(unique, func(i, j int) bool {
+		if si, sj := unique[i].att.GetData().Slot, unique[j].att.GetData().Slot; si != sj {
+			return si < sj
+		}
+		if unique[i].key != unique[j].key {
+			return unique[i].key < unique[j].key
+		}
+		return bytes.Compare(unique[i].att.GetAggregationBits(), unique[j].att.GetAggregationBits()) < 0
+	})
+	deduped := make([]eth.Att, len(unique))
+	for i, u := range unique {
+		deduped[i] = u.att
+	}
+	return deduped, nil
+}
+
+// attestationDataKey returns the key under which attestations with the same data are grouped: the hash tree root
+// of the attestation data, followed by the committee bits for Electra attestations.
+func attestationDataKey(att eth.Att) (string, error) {
+	dataRoot, err := att.GetData().HashTreeRoot()
+	if err != nil {
+		return "", errors.Wrap(err, "could not hash attestation data")
+	}
+	key := string(dataRoot[:])
+	if att.Version() >= version.Electra {
+		key += string(att.CommitteeBitsVal().Bytes())
+	}
+	return key, nil
+}
+
+// paginateListData returns the page of items starting at offset and holding up to limit items.
+// All items from offset on are returned when no limit is supplied, and no items when offset is past the end.
+func paginateListData(items []interface{}, rawLimit string, limit, offset uint64) []interface{} {
+	if offset >= uint64(len(items)) {
+		return []interface{}{}
+	}
+	items = items[offset:]
+	if rawLimit != "" && limit < uint64(len(items)) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// shouldIncludeAttestation determines if an attestation matches the slot, committee index and FFG epoch filters.
+// An attestation matches the committee index filter when it belongs to any of the supplied committees.
+func shouldIncludeAttestation(
+	att eth.Att,
+	rawSlot string,
+	slot uint64,
+	committeeIndices map[primitives.CommitteeIndex]struct{},
+	ffgEpochs ffgEpochsFilter,
+) bool {
+	if rawSlot != "" && att.GetData().Slot != primitives.Slot(slot) {
+		return false
+	}
+	if ffgEpochs.rawSource != "" && att.GetData().Source.Epoch != ffgEpochs.source {
+		return false
+	}
+	if ffgEpochs.rawTarget != "" && att.GetData().Target.Epoch != ffgEpochs.target {
+		return false
+	}
+	if len(committeeIndices) == 0 {
+		return true
+	}
+	for committeeIndex := range committeeIndices {
+		if attestationInCommittee(att, committeeIndex) {
+			return true
+		}
+	}
+	return false
+}
+
+// committeeIndicesFromQuery parses the set of committee indices from the committee_index query parameter,
+// which can be repeated or hold comma-separated values. An empty set means that no index was supplied.
+func committeeIndicesFromQuery(w http.ResponseWriter, r *http.Request) (map[primitives.CommitteeIndex]struct{}, bool) {
+	values, ok := shared.UintsFromQuery(w, r, "committee_index")
+	if !ok {
+		return nil, false
+	}
+	committeeIndices := make(map[primitives.CommitteeIndex]struct{}, len(values))
+	for _, v := range values {
+		committeeIndices[primitives.CommitteeIndex(v)] = struct{}{}
+	}
+	return committeeIndices, true
+}
+
+// ffgEpochsFilter holds the optional source and target epochs that listed attestations must vote for.
+// An empty raw value means that the corresponding query parameter was not supplied.
+type ffgEpochsFilter struct {
+	rawSource string
+	source    primitives.Epoch
+	rawTarget string
+	target    primitives.Epoch
+}
+
+// ffgEpochsFromQuery parses the optional source_epoch and target_epoch query parameters.
+func ffgEpochsFromQuery(w http.ResponseWriter, r *http.Request) (ffgEpochsFilter, bool) {
+	rawSource, source, ok := shared.UintFromQuery(w, r, "source_epoch", false)
+	if !ok {
+		return ffgEpochsFilter{}, false
+	}
+	rawTarget, target, ok := shared.UintFromQuery(w, r, "target_epoch", false)
+	if !ok {
+		return ffgEpochsFilter{}, false
+	}
+	return ffgEpochsFilter{
+		rawSource: rawSource,
+		source:    primitives.Epoch(source),
+		rawTarget: rawTarget,
+		target:    primitives.Epoch(target),
+	}, true
+}
+
+// epochFromQuery parses the optional epoch query parameter, which is rejected when the slot query parameter is also supplied.
+func epochFromQuery(w http.ResponseWriter, r *http.Request, rawSlot string) (string, primitives.Epoch, bool) {
+	rawEpoch, epoch, ok := shared.UintFromQuery(w, r, "epoch", false)
+	if !ok {
+		return "", 0, false
+	}
+	if rawEpoch != "" && rawSlot != "" {
+		httputil.HandleError(w, "slot and epoch query parameters are mutually exclusive", http.StatusBadRequest)
+		return "", 0, false
+	}
+	return rawEpoch, primitives.Epoch(epoch), true
+}
+
+// validateCommitteeIndices checks that every committee index is lower than the committee count per slot.
+// The committee count is computed from the active validators of the head state at the epoch of the slot,
+// at the supplied epoch, or at the head epoch when neither a slot nor an epoch is supplied.
+func validateCommitteeIndices(
+	ctx context.Context,
+	w http.ResponseWriter,
+	headState state.ReadOnlyBeaconState,
+	rawSlot string,
+	slot uint64,
+	rawEpoch string,
+	queryEpoch primitives.Epoch,
+	committeeIndices map[primitives.CommitteeIndex]struct{},
+) bool {
+	if len(committeeIndices) == 0 {
+		return true
+	}
+	epoch := slots.ToEpoch(headState.Slot())
+	if rawSlot != "" {
+		epoch = slots.ToEpoch(primitives.Slot(slot))
+	} else if rawEpoch != "" {
+		epoch = queryEpoch
+	}
+	activeCount, err := corehelpers.ActiveValidatorCount(ctx, headState, epoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not get active validator count: "+err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	committeeCount := corehelpers.SlotCommitteeCount(activeCount)
+	var maxIndex primitives.CommitteeIndex
+	for index := range committeeIndices {
+		if index > maxIndex {
+			maxIndex = index
+		}
+	}
+	if uint64(maxIndex) >= committeeCount {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("committee_index %d is out of range: the committee count per slot is %d", maxIndex, committeeCount),
+			http.StatusBadRequest,
+		)
+		return false
+	}
+	return true
+}
+
+// attestationInCommittee reports whether the attestation includes votes from the given committee.
+// Pre-Electra attestations carry the committee index in their data. Electra attestations always
+// set the data's committee index to 0 and identify their committees through committee bits instead.
+func attestationInCommittee(att eth.Att, committeeIndex primitives.CommitteeIndex) bool {
+	if att.Version() < version.Electra {
+		return att.GetData().CommitteeIndex == committeeIndex
+	}
+	committeeBits := att.CommitteeBitsVal()
+	return uint64(committeeIndex) < committeeBits.Len() && committeeBits.BitAt(uint64(committeeIndex))
+}
+
+// isSingletonAttestation reports whether exactly one aggregation bit is set in the attestation.
+// This is stricter than the aggregated/unaggregated split, as an unaggregated pool may still hold multi-bit attestations.
+func isSingletonAttestation(att eth.Att) bool {
+	return att.GetAggregationBits().Count() == 1
+}
+
+// SubmitAttestations submits an attestation object to node. If the attestation passes all validation
+// constraints, node MUST publish the attestation on an appropriate subnet.
+// When the node has fewer peers than the configured minimum, attestations are only saved to the pool
+// and the response indicates that the broadcast was deferred.
+// Clients accepting `application/x-ndjson` receive one JSON line per attestation as soon as its result is known.
+// When the `only_if_slot` query parameter is passed and the current slot differs from it,
+// the whole request is rejected with a 409 without processing any attestation.
+// Every submission is assigned a receipt ID, returned in the X-Receipt-Id header and the `receipt_id` field,
+// that can be passed to GetAttestationReceipt to query the outcome of the attestations later.
+// When every attestation succeeds, a 200 is returned without a body, unless the broadcast was deferred or some
+// attestations were already pooled, in which case the statuses of the attestations are returned.
+// When every attestation fails validation, a 400 is returned, and a 500 when some of them could not be broadcast.
+// When only some of them fail validation or could not be broadcast, the other attestations are still broadcast and
+// pooled, and a 202 is returned with the number of accepted attestations, their statuses and the failures.
+func (s *Server) SubmitAttestations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestations")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	rawOnlyIfSlot, onlyIfSlot, ok := shared.UintFromQuery(w, r, "only_if_slot", false)
+	if !ok {
+		return
+	}
+	if rawOnlyIfSlot != "" {
+		if currentSlot := s.GenesisTimeFetcher.CurrentSlot(); currentSlot != primitives.Slot(onlyIfSlot) {
+			httputil.HandleError(
+				w,
+				fmt.Sprintf("Slot has passed: current slot %d does not match slot %d", currentSlot, onlyIfSlot),
+				http.StatusConflict,
+			)
+			return
+		}
+	}
+
+	var req structs.SubmitAttestationsRequest
+	if !shared.DecodeJSONBody(w, r, &req.Data) {
+		return
+	}
+
+	level, ok := s.attestationVerificationLevel(w, r)
+	if !ok {
+		return
+	}
+
+	targetsLister, ok := s.publishTargetsLister(w, r)
+	if !ok {
+		return
+	}
+
+	deferBroadcast := s.attestationBroadcastDeferred()
+	receiptID := s.AttestationReceipts.Create()
+	if receiptID != "" {
+		w.Header().Set(api.ReceiptIDHeader, receiptID)
+	}
+	recordReceipt := s.attestationReceiptRecorder(receiptID, deferBroadcast)
+	if httputil.RespondWithNdjson(r) {
+		s.streamAttestationResults(ctx, w, req.Data, level, deferBroadcast, recordReceipt)
+		return
+	}
+	attFailures, failedBroadcasts, broadcasts, statuses, err := s.handleAttestations(ctx, version.Phase0, req.Data, level, deferBroadcast, recordReceipt)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.recordSubmissionRejections(attFailures, len(failedBroadcasts))
+
+	if writeAttestationSubmissionFailures(w, attFailures, failedBroadcasts, statuses, deferBroadcast, receiptID, nil) {
+		return
+	}
+
+	if targetsLister != nil && !deferBroadcast {
+		writeAttestationPublishTargets(w, targetsLister, broadcasts, statuses, receiptID)
+		return
+	}
+	if !attestationSubmissionHasDetails(statuses, deferBroadcast) {
+		return
+	}
+	httputil.WriteJson(w, &structs.SubmitAttestationsResponse{
+		BroadcastDeferred: deferBroadcast,
+		Statuses:          statuses,
+		ReceiptID:         receiptID,
+	})
+}
+
+// SubmitAttestationsAndAggregate submits unaggregated attestations in the same way as SubmitAttestations and then
+// merges the submitted attestations that share the same attestation data into aggregates, which are saved to the pool.
+// The SSZ encoding of every aggregate produced from more than one submitted attestation is returned along with
+// the request indices of the attestations it was produced from, so that clients can verify and reuse it.
+// Only attestations that passed validation and were saved to the pool are merged, and an attestation whose
+// aggregation bits overlap with the ones already merged for the same data is left out of the aggregate.
+// Failures are reported in the same way as in SubmitAttestations, with the aggregates included in the 202 response.
+func (s *Server) SubmitAttestationsAndAggregate(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestationsAndAggregate")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	var req structs.SubmitAttestationsRequest
+	if !shared.DecodeJSONBody(w, r, &req.Data) {
+		return
+	}
+
+	level, ok := s.attestationVerificationLevel(w, r)
+	if !ok {
+		return
+	}
+
+	deferBroadcast := s.attestationBroadcastDeferred()
+	attFailures, failedBroadcasts, _, statuses, err := s.handleAttestations(ctx, version.Phase0, req.Data, level, deferBroadcast, nil)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.recordSubmissionRejections(attFailures, len(failedBroadcasts))
+
+	// The request was already decoded successfully when handling the attestations.
+	var sourceAttestations []*structs.Attestation
+	if err = json.Unmarshal(req.Data, &sourceAttestations); err != nil {
+		httputil.HandleError(w, "Could not unmarshal attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	atts := make(map[int]*eth.Attestation, len(statuses))
+	for _, status := range statuses {
+		i, err := strconv.Atoi(status.Index)
+		if err != nil {
+			httputil.HandleError(w, "Could not parse attestation index: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		att, err := sourceAttestations[i].ToConsensus()
+		if err != nil {
+			httputil.HandleError(w, "Could not convert request attestation to consensus attestation: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		atts[i] = att
+	}
+
+	aggregates, err := aggregateSubmittedAttestations(atts)
+	if err != nil {
+		httputil.HandleError(w, "Could not aggregate attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &structs.SubmitAttestationsAndAggregateResponse{
+		BroadcastDeferred: deferBroadcast,
+		Statuses:          statuses,
+		Aggregates:        make([]*structs.SubmittedAttestationsAggregate, 0, len(aggregates)),
+	}
+	for _, a := range aggregates {
+		s.saveAttestationToPool(a.att)
+		sszBytes, err := a.att.MarshalSSZ()
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal aggregate to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		indices := make([]string, len(a.indices))
+		for i, index := range a.indices {
+			indices[i] = strconv.Itoa(index)
+		}
+		resp.Aggregates = append(resp.Aggregates, &structs.SubmittedAttestationsAggregate{
+			Indices: indices,
+			Ssz:     hexutil.Encode(sszBytes),
+		})
+	}
+
+	if writeAttestationSubmissionFailures(w, attFailures, failedBroadcasts, statuses, deferBroadcast, "", resp.Aggregates) {
+		return
+	}
+	httputil.WriteJson(w, resp)
+}
+
+type submittedAttestationsAggregate struct {
+	indices []int
+	att     eth.Att
+}
+
+// aggregateSubmittedAttestations merges the unaggregated attestations, keyed by their request index, that share
+// the same attestation data. Only aggregates produced from more than one attestation are returned,
+// ordered by the lowest request index they were produced from.
+func aggregateSubmittedAttestations(atts map[int]*eth.Attestation) ([]*submittedAttestationsAggregate, error) {
+	indices := make([]int, 0, len(atts))
+	for i := range atts {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+
+	type group struct {
+		indices  []int
+		atts     []eth.Att
+		coverage bitfield.Bitlist
+	}
+	var groups []*group
+	groupsByRoot := make(map[[32]byte]*group)
+	for _, i := range indices {
+		att := atts[i]
+		if corehelpers.IsAggregated(att) {
+			continue
+		}
+		root, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute attestation data root")
+		}
+		g, ok := groupsByRoot[root]
+		if !ok {
+			g = &group{coverage: bitfield.NewBitlist(att.AggregationBits.Len())}
+			groupsByRoot[root] = g
+			groups = append(groups, g)
+		}
+		overlaps, err := g.coverage.Overlaps(att.AggregationBits)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compare aggregation bits of attestation %d", i)
+		}
+		if overlaps {
+			continue
+		}
+		if g.coverage, err = g.coverage.Or(att.AggregationBits); err != nil {
+			return nil, errors.Wrapf(err, "could not merge aggregation bits of attestation %d", i)
+		}
+		g.indices = append(g.indices, i)
+		g.atts = append(g.atts, att.Copy())
+	}
+
+	var aggregates []*submittedAttestationsAggregate
+	for _, g := range groups {
+		if len(g.atts) < 2 {
+			continue
+		}
+		aggregate, err := attaggregation.AggregateDisjointOneBitAtts(g.atts)
+		if err != nil {
+			return nil, err
+		}
+		aggregates = append(aggregates, &submittedAttestationsAggregate{indices: g.indices, att: aggregate})
+	}
+	return aggregates, nil
+}
+
+// attestationReceiptRecorder returns a result callback recording the outcome of every attestation in the receipt,
+// or nil when the submission is not tracked by a receipt.
+func (s *Server) attestationReceiptRecorder(receiptID string, deferBroadcast bool) attestationResultFunc {
+	if receiptID == "" {
+		return nil
+	}
+	return func(result *structs.AttestationSubmissionStatus) {
+		index, err := strconv.Atoi(result.Index)
+		if err != nil {
+			log.WithError(err).Debug("Could not parse attestation index")
+			return
+		}
+		outcome := AttestationReceiptOutcome{Index: index, Message: result.Message}
+		switch {
+		case result.Status == "broadcast_failed" && result.BroadcastFailure == broadcastFailureRetained:
+			outcome.Status = AttestationReceiptRetryPending
+		case result.Status == "invalid" || result.Status == "broadcast_failed":
+			outcome.Status = AttestationReceiptFailed
+		case deferBroadcast:
+			outcome.Status = AttestationReceiptPooled
+		default:
+			outcome.Status = AttestationReceiptBroadcast
+		}
+		s.AttestationReceipts.Record(receiptID, outcome)
+	}
+}
+
+// GetAttestationReceipt returns the outcomes of the attestations submitted in the request identified by
+// the `receipt_id` path parameter, in the order they became known. Only a bounded number of receipts is retained,
+// so the receipts of old submissions are eventually evicted and reported as not found.
+func (s *Server) GetAttestationReceipt(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetAttestationReceipt")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	receiptID := r.PathValue("receipt_id")
+	if receiptID == "" {
+		httputil.HandleError(w, "receipt_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	outcomes, ok := s.AttestationReceipts.Get(receiptID)
+	if !ok {
+		httputil.HandleError(w, "Attestation receipt not found", http.StatusNotFound)
+		return
+	}
+
+	data := &structs.AttestationReceipt{
+		ReceiptID: receiptID,
+		Outcomes:  make([]*structs.AttestationReceiptOutcome, len(outcomes)),
+	}
+	for i, o := range outcomes {
+		data.Outcomes[i] = &structs.AttestationReceiptOutcome{
+			Index:   strconv.Itoa(o.Index),
+			Status:  o.Status,
+			Message: o.Message,
+		}
+	}
+	httputil.WriteJson(w, &structs.GetAttestationReceiptResponse{Data: data})
+}
+
+// streamAttestationResults handles the submitted attestations and writes the result of every attestation
+// as a separate JSON line, flushing it to the client as soon as it is known.
+// When recordReceipt is not nil, it is invoked with every result before the result is written.
+func (s *Server) streamAttestationResults(
+	ctx context.Context,
+	w http.ResponseWriter,
+	data json.RawMessage,
+	level core.VerificationLevel,
+	deferBroadcast bool,
+	recordReceipt attestationResultFunc,
+) {
+	rc := http.NewResponseController(w)
+	enc := json.NewEncoder(w)
+	started := false
+	onResult := func(result *structs.AttestationSubmissionStatus) {
+		if recordReceipt != nil {
+			recordReceipt(result)
+		}
+		if !started {
+			w.Header().Set("Content-Type", api.NdjsonMediaType)
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if err := enc.Encode(result); err != nil {
+			log.WithError(err).Debug("Could not write attestation submission result")
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			log.WithError(err).Debug("Could not flush attestation submission result")
+		}
+	}
+	// Errors are only returned before the result of any attestation is known, so the response has not been started yet.
+	attFailures, failedBroadcasts, _, _, err := s.handleAttestations(ctx, version.Phase0, data, level, deferBroadcast, onResult)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.recordSubmissionRejections(attFailures, len(failedBroadcasts))
+}
+
+// SubmitAttestationsV2 submits an attestation object to node. If the attestation passes all validation
+// constraints, node MUST publish the attestation on an appropriate subnet.
+// Broadcasting is deferred in the same way as in SubmitAttestations, and batches in which only some attestations
+// fail validation or could not be broadcast result in a 202 in the same way as well.
+func (s *Server) SubmitAttestationsV2(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestationsV2")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	versionHeader := r.Header.Get(api.VersionHeader)
+	if versionHeader == "" {
+		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
+		return
+	}
+	v, err := version.FromString(versionHeader)
+	if err != nil {
+		httputil.HandleError(w, "Invalid version: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req structs.SubmitAttestationsRequest
+	if !shared.DecodeJSONBody(w, r, &req.Data) {
+		return
+	}
+
+	level, ok := s.attestationVerificationLevel(w, r)
+	if !ok {
+		return
+	}
+
+	targetsLister, ok := s.publishTargetsLister(w, r)
+	if !ok {
+		return
+	}
+
+	s.submitVersionedAttestations(ctx, w, v, req.Data, level, targetsLister)
+}
+
+// SubmitAttestationProtobuf submits a single attestation encoded in the protobuf wire format, for internal clients
+// that speak the consensus protobuf types directly. The body holds an `Attestation`, or an `AttestationElectra` when
+// the Eth-Consensus-Version header names Electra or a later fork. The attestation is validated, broadcast and pooled
+// as in SubmitAttestationsV2, including the handling of the `verification_level` and `include_publish_targets` query parameters.
+func (s *Server) SubmitAttestationProtobuf(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestationProtobuf")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	versionHeader := r.Header.Get(api.VersionHeader)
+	if versionHeader == "" {
+		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
+		return
+	}
+	v, err := version.FromString(versionHeader)
+	if err != nil {
+		httputil.HandleError(w, "Invalid version: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(body) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	var att eth.Att = &eth.Attestation{}
+	if v >= version.Electra {
+		att = &eth.AttestationElectra{}
+	}
+	if err = proto.Unmarshal(body, att); err != nil {
+		httputil.HandleError(w, "Could not decode request body into consensus attestation: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if att.GetData() == nil || att.GetData().Source == nil || att.GetData().Target == nil {
+		httputil.HandleError(w, "Could not decode request body into consensus attestation: attestation data is incomplete", http.StatusBadRequest)
+		return
+	}
+	// The attestation is converted to its JSON representation so that it goes through the exact same handling
+	// as attestations submitted in JSON.
+	var data []byte
+	switch a := att.(type) {
+	case *eth.Attestation:
+		data, err = json.Marshal([]*structs.Attestation{structs.AttFromConsensus(a)})
+	case *eth.AttestationElectra:
+		data, err = json.Marshal([]*structs.AttestationElectra{structs.AttElectraFromConsensus(a)})
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal attestation: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	level, ok := s.attestationVerificationLevel(w, r)
+	if !ok {
+		return
+	}
+	targetsLister, ok := s.publishTargetsLister(w, r)
+	if !ok {
+		return
+	}
+
+	s.submitVersionedAttestations(ctx, w, v, data, level, targetsLister)
+}
+
+// submitVersionedAttestations validates, broadcasts and pools the attestations of the given fork version
+// and writes the outcome as the response. The publish targets are written when targetsLister is not nil.
+func (s *Server) submitVersionedAttestations(
+	ctx context.Context,
+	w http.ResponseWriter,
+	v int,
+	data json.RawMessage,
+	level core.VerificationLevel,
+	targetsLister attestationSubnetPeerLister,
+) {
+	deferBroadcast := s.attestationBroadcastDeferred()
+	attFailures, failedBroadcasts, broadcasts, statuses, err := s.handleAttestations(ctx, v, data, level, deferBroadcast, nil)
+	if err != nil {
+		httputil.HandleError(w, fmt.Sprintf("Failed to handle attestations: %v", err), http.StatusBadRequest)
+		return
+	}
+	s.recordSubmissionRejections(attFailures, len(failedBroadcasts))
+
+	if writeAttestationSubmissionFailures(w, attFailures, failedBroadcasts, statuses, deferBroadcast, "", nil) {
+		return
+	}
+
+	if targetsLister != nil && !deferBroadcast {
+		writeAttestationPublishTargets(w, targetsLister, broadcasts, statuses, "")
+		return
+	}
+	if !attestationSubmissionHasDetails(statuses, deferBroadcast) {
+		return
+	}
+	httputil.WriteJson(w, &structs.SubmitAttestationsResponse{BroadcastDeferred: deferBroadcast, Statuses: statuses})
+}
+
+// ValidateAttestations runs the validation applied to submitted attestations and reports, for every attestation,
+// whether it passed. Attestations are neither saved to the pool nor broadcast. The `verification_level` query parameter
+// is honored in the same way as in SubmitAttestations, so `strict` performs full signature verification.
+// Electra attestations are expected when the Eth-Consensus-Version header names Electra or a later fork.
+func (s *Server) ValidateAttestations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.ValidateAttestations")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	v := version.Phase0
+	if versionHeader := r.Header.Get(api.VersionHeader); versionHeader != "" {
+		var err error
+		v, err = version.FromString(versionHeader)
+		if err != nil {
+			httputil.HandleError(w, "Invalid version: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var req structs.SubmitAttestationsRequest
+	if !shared.DecodeJSONBody(w, r, &req.Data) {
+		return
+	}
+
+	level, ok := s.attestationVerificationLevel(w, r)
+	if !ok {
+		return
+	}
+
+	atts, conversionErrs, err := decodeAttestations(req.Data, v)
+	if err != nil {
+		httputil.HandleError(w, "Could not unmarshal attestations: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(atts) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+
+	headState, err := s.verificationHeadState(ctx, level)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	currentSlot := s.GenesisTimeFetcher.CurrentSlot()
+	committeeCounts := make(map[primitives.Epoch]uint64)
+	results := make([]*structs.AttestationValidationResult, len(atts))
+	for i, att := range atts {
+		result := &structs.AttestationValidationResult{Index: strconv.Itoa(i), Valid: true}
+		if message := s.attestationValidationFailure(ctx, att, conversionErrs[i], currentSlot, committeeCounts); message != "" {
+			result.Valid = false
+			result.Message = message
+		} else if err = verifyAttestation(ctx, headState, att, level); err != nil {
+			result.Valid = false
+			result.Message = "Incorrect attestation signature: " + err.Error()
+		}
+		results[i] = result
+	}
+	httputil.WriteJson(w, &structs.ValidateAttestationsResponse{Data: results})
+}
+
+// SubmitAggregateAndProofs verifies signed aggregate and proofs and saves the aggregates to the attestation pool.
+// Unlike aggregated attestations submitted through SubmitAttestations, the aggregator's membership in the committee,
+// its selection proof and the aggregate and proof signature are verified along with the aggregate signature,
+// always using the head state. Aggregates are saved to the pool but not broadcast.
+// Every verified aggregate and proof, pre-Electra or Electra, whose aggregate was not already in the pool is sent on the
+// operation feed as an aggregated attestation event. When some aggregate and proofs fail validation, the response lists
+// the failures along with the statuses of the aggregates that were saved to the pool.
+// Electra aggregate and proofs are expected when the Eth-Consensus-Version header names Electra or a later fork.
+func (s *Server) SubmitAggregateAndProofs(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAggregateAndProofs")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	v := version.Phase0
+	if versionHeader := r.Header.Get(api.VersionHeader); versionHeader != "" {
+		var err error
+		v, err = version.FromString(versionHeader)
+		if err != nil {
+			httputil.HandleError(w, "Invalid version: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var req structs.SubmitAggregateAndProofsRequest
+	if !shared.DecodeJSONBody(w, r, &req.Data) {
+		return
+	}
+	if len(req.Data) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	currentSlot := s.GenesisTimeFetcher.CurrentSlot()
+	committeeCounts := make(map[primitives.Epoch]uint64)
+	var failures []*server.IndexedVerificationFailure
+	statuses := make([]*structs.AttestationSubmissionStatus, 0, len(req.Data))
+	for i, raw := range req.Data {
+		signed, err := decodeSignedAggregateAndProof(raw, v)
+		if err != nil {
+			failures = append(failures, &server.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not convert request aggregate and proof to consensus aggregate and proof: " + err.Error(),
+			})
+			continue
+		}
+		aggregateAndProof := signed.AggregateAttestationAndProof()
+		aggregate := aggregateAndProof.AggregateVal()
+		message := s.attestationValidationFailure(ctx, aggregate, nil, currentSlot, committeeCounts)
+		if message == "" {
+			if err = verifyAggregateAndProof(ctx, headState, signed); err != nil {
+				message = "Invalid aggregate and proof: " + err.Error()
+			}
+		}
+		if message != "" {
+			failures = append(failures, &server.IndexedVerificationFailure{Index: i, Message: message})
+			continue
+		}
+
+		added := s.saveAttestationToPool(aggregate)
+		statuses = append(statuses, attestationSubmissionStatus(i, added))
+		if !added {
+			continue
+		}
+		// Now that the selection proof is known to be valid, other services in the beacon node
+		// can be notified of the received aggregate.
+		s.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.AggregatedAttReceived,
+			Data: &operation.AggregatedAttReceivedData{
+				Attestation: aggregateAndProof,
+			},
+		})
+	}
+	s.recordSubmissionRejections(failures, 0)
+
+	if len(failures) > 0 {
+		httputil.WriteError(w, &structs.SubmitAggregateAndProofsFailureResponse{
+			Code:     http.StatusBadRequest,
+			Message:  "One or more aggregate and proofs failed validation",
+			Failures: failures,
+			Statuses: statuses,
+		})
+		return
+	}
+	httputil.WriteJson(w, &structs.SubmitAggregateAndProofsResponse{Statuses: statuses})
+}
+
+// decodeSignedAggregateAndProof decodes a signed aggregate and proof of the given version from the request.
+func decodeSignedAggregateAndProof(raw json.RawMessage, v int) (eth.SignedAggregateAttAndProof, error) {
+	if v >= version.Electra {
+		var signed structs.SignedAggregateAttestationAndProofElectra
+		if err := json.Unmarshal(raw, &signed); err != nil {
+			return nil, err
+		}
+		return signed.ToConsensus()
+	}
+	var signed structs.SignedAggregateAttestationAndProof
+	if err := json.Unmarshal(raw, &signed); err != nil {
+		return nil, err
+	}
+	return signed.ToConsensus()
+}
+
+// verifyAggregateAndProof checks that the aggregator belongs to the committee of the aggregate and was selected
+// as an aggregator for the slot, and verifies the selection proof, the aggregate and proof signature
+// and the aggregate signature against the head state.
+func verifyAggregateAndProof(ctx context.Context, headState state.ReadOnlyBeaconState, signed eth.SignedAggregateAttAndProof) error {
+	aggregateAndProof := signed.AggregateAttestationAndProof()
+	aggregatorIndex := aggregateAndProof.GetAggregatorIndex()
+	aggregate := aggregateAndProof.AggregateVal()
+	data := aggregate.GetData()
+
+	committeeIndex, err := aggregate.GetCommitteeIndex()
+	if err != nil {
+		return errors.Wrap(err, "could not get committee index")
+	}
+	committee, err := corehelpers.BeaconCommitteeFromState(ctx, headState, data.Slot, committeeIndex)
+	if err != nil {
+		return errors.Wrap(err, "could not get committee")
+	}
+	inCommittee := false
+	for _, idx := range committee {
+		if idx == aggregatorIndex {
+			inCommittee = true
+			break
+		}
+	}
+	if !inCommittee {
+		return fmt.Errorf("aggregator %d is not a member of committee %d at slot %d", aggregatorIndex, committeeIndex, data.Slot)
+	}
+
+	isAggregator, err := corehelpers.IsAggregator(uint64(len(committee)), aggregateAndProof.GetSelectionProof())
+	if err != nil {
+		return errors.Wrap(err, "could not check aggregator selection")
+	}
+	if !isAggregator {
+		return fmt.Errorf("validator %d is not selected as an aggregator for slot %d", aggregatorIndex, data.Slot)
+	}
+
+	epoch := slots.ToEpoch(data.Slot)
+	slotRoot := primitives.SSZUint64(data.Slot)
+	if err = signing.ComputeDomainVerifySigningRoot(
+		headState,
+		aggregatorIndex,
+		epoch,
+		&slotRoot,
+		params.BeaconConfig().DomainSelectionProof,
+		aggregateAndProof.GetSelectionProof(),
+	); err != nil {
+		return errors.Wrap(err, "could not verify selection proof")
+	}
+	if err = signing.ComputeDomainVerifySigningRoot(
+		headState,
+		aggregatorIndex,
+		epoch,
+		aggregateAndProof,
+		params.BeaconConfig().DomainAggregateAndProof,
+		signed.GetSignature(),
+	); err != nil {
+		return errors.Wrap(err, "could not verify aggregate and proof signature")
+	}
+	return verifyAttestation(ctx, headState, aggregate, core.VerificationLevelStrict)
+}
+
+// attestationBroadcast identifies an attestation from a submission request that was handed to the broadcaster.
+type attestationBroadcast struct {
+	index  int
+	subnet uint64
+}
+
+// attestationSubnetPeerLister is implemented by broadcasters that can report the peers subscribed to an attestation subnet.
+type attestationSubnetPeerLister interface {
+	AttestationSubnetPeers(subnet uint64) ([]peer.ID, error)
+}
+
+// publishTargetsLister returns the broadcaster as an attestationSubnetPeerLister when the `include_publish_targets`
+// query parameter is set, or nil otherwise. The capability is checked before any attestation is handled,
+// so that a request that cannot be served does not broadcast or pool anything.
+func (s *Server) publishTargetsLister(w http.ResponseWriter, r *http.Request) (attestationSubnetPeerLister, bool) {
+	includeTargets, ok := shared.BoolFromQuery(w, r, "include_publish_targets")
+	if !ok || !includeTargets {
+		return nil, ok
+	}
+	lister, ok := s.Broadcaster.(attestationSubnetPeerLister)
+	if !ok {
+		httputil.HandleError(w, "Broadcaster does not expose publish targets", http.StatusNotImplemented)
+		return nil, false
+	}
+	return lister, true
+}
+
+// writeAttestationPublishTargets writes, for every broadcast attestation, the peers subscribed to the subnet
+// the attestation was published on. A peer being listed does not guarantee it received the attestation,
+// but the list helps with debugging one-sided connectivity. As the attestations were already broadcast and pooled,
+// a failed peer lookup is reported for the affected attestation instead of failing the request.
+func writeAttestationPublishTargets(
+	w http.ResponseWriter,
+	lister attestationSubnetPeerLister,
+	broadcasts []attestationBroadcast,
+	statuses []*structs.AttestationSubmissionStatus,
+	receiptID string,
+) {
+	targets := make([]*structs.AttestationPublishTargets, len(broadcasts))
+	for i, b := range broadcasts {
+		targets[i] = &structs.AttestationPublishTargets{
+			Index:  strconv.Itoa(b.index),
+			Subnet: strconv.FormatUint(b.subnet, 10),
+			Peers:  []string{},
+		}
+		peers, err := lister.AttestationSubnetPeers(b.subnet)
+		if err != nil {
+			targets[i].Error = "Could not get subnet peers: " + err.Error()
+			continue
+		}
+		for _, p := range peers {
+			targets[i].Peers = append(targets[i].Peers, p.String())
+		}
+	}
+	httputil.WriteJson(w, &structs.SubmitAttestationsPublishTargetsResponse{
+		Data:      targets,
+		Statuses:  statuses,
+		ReceiptID: receiptID,
+	})
+}
+
+// attestationVerificationLevel returns the verification level requested through the `verification_level` query parameter,
+// falling back to the server's configured level. A request may only ask for a level that is at least as strict
+// as the configured one, otherwise any API caller could bypass the checks chosen by the node operator.
+func (s *Server) attestationVerificationLevel(w http.ResponseWriter, r *http.Request) (core.VerificationLevel, bool) {
+	configured, err := core.VerificationLevelFromString(string(s.AttestationVerificationLevel))
+	if err != nil {
+		httputil.HandleError(w, "Invalid configured verification level: "+err.Error(), http.StatusInternalServerError)
+		return "", false
+	}
+	raw := r.URL.Query().Get("verification_level")
+	if raw == "" {
+		return configured, true
+	}
+	level, err := core.VerificationLevelFromString(raw)
+	if err != nil {
+		httputil.HandleError(w, "Invalid verification level: "+err.Error(), http.StatusBadRequest)
+		return "", false
+	}
+	if !level.AtLeast(configured) {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Invalid verification level: %s is less strict than the level %s configured on the node", level, configured),
+			http.StatusBadRequest,
+		)
+		return "", false
+	}
+	return level, true
+}
+
+// verifyAttestation runs the checks required by the verification level on an attestation converted from the request.
+// The head state is only used for strict verification.
+func verifyAttestation(ctx context.Context, headState state.ReadOnlyBeaconState, att eth.Att, level core.VerificationLevel) error {
+	if level == core.VerificationLevelMinimal {
+		return nil
+	}
+	if _, err := bls.SignatureFromBytes(att.GetSignature()); err != nil {
+		return err
+	}
+	if level != core.VerificationLevelStrict {
+		return nil
+	}
+	set, err := blocks.AttestationSignatureBatch(ctx, headState, []eth.Att{att})
+	if err != nil {
+		return errors.Wrap(err, "could not get attestation signature set")
+	}
+	valid, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify attestation signature")
+	}
+	if !valid {
+		return errors.New("signature did not verify against the attesting committee")
+	}
+	return nil
+}
+
+// verifyAttestationRoots checks that none of the roots referenced by the attestation is the zero hash,
+// which is a common symptom of a serialization bug in the submitting client. The source root is only
+// allowed to be zero for the genesis epoch, as the genesis checkpoint has a zero root.
+func verifyAttestationRoots(data *eth.AttestationData) error {
+	if bytesutil.ZeroRoot(data.BeaconBlockRoot) {
+		return errors.New("beacon block root is zero")
+	}
+	if data.Source.Epoch != 0 && bytesutil.ZeroRoot(data.Source.Root) {
+		return errors.New("source root is zero")
+	}
+	if bytesutil.ZeroRoot(data.Target.Root) {
+		return errors.New("target root is zero")
+	}
+	return nil
+}
+
+// verifyAttestationEpochs checks that the target epoch is the epoch of the attestation slot
+// and that the source epoch does not come after the target epoch.
+func verifyAttestationEpochs(data *eth.AttestationData) error {
+	if slotEpoch := slots.ToEpoch(data.Slot); data.Target.Epoch != slotEpoch {
+		return fmt.Errorf("target epoch %d does not match epoch %d of slot %d", data.Target.Epoch, slotEpoch, data.Slot)
+	}
+	if data.Source.Epoch > data.Target.Epoch {
+		return fmt.Errorf("source epoch %d is greater than target epoch %d", data.Source.Epoch, data.Target.Epoch)
+	}
+	return nil
+}
+
+// verifyAttestationSource checks that the source of the attestation is the current or the previous justified
+// checkpoint known to fork choice. Both are accepted, as attestations targeting the previous epoch
+// use the checkpoint that was justified at that time. The check only runs when VerifyAttestationSource is set.
+func (s *Server) verifyAttestationSource(data *eth.AttestationData) error {
+	if !s.VerifyAttestationSource {
+		return nil
+	}
+	current := s.FinalizationFetcher.CurrentJustifiedCheckpt()
+	previous := s.FinalizationFetcher.PreviousJustifiedCheckpt()
+	for _, cp := range []*eth.Checkpoint{current, previous} {
+		if cp != nil && data.Source.Epoch == cp.Epoch && bytes.Equal(data.Source.Root, cp.Root) {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"source checkpoint (epoch %d, root %#x) does not match the justified checkpoint (epoch %d, root %#x)",
+		data.Source.Epoch,
+		data.Source.Root,
+		current.GetEpoch(),
+		current.GetRoot(),
+	)
+}
+
+// verifyAttestationCommittee checks that the committees of the attestation exist at its slot, i.e. that its committee
+// index is lower than the committee count per slot computed from the active validators of the head state at the epoch
+// of the attestation. For Electra attestations, the highest index set in the committee bits is checked.
+// Committee counts are cached per epoch in counts, which is shared by the attestations of a request.
+// The check only runs when VerifyAttestationCommittee is set.
+func (s *Server) verifyAttestationCommittee(ctx context.Context, att eth.Att, counts map[primitives.Epoch]uint64) error {
+	if !s.VerifyAttestationCommittee {
+		return nil
+	}
+	epoch := slots.ToEpoch(att.GetData().Slot)
+	count, ok := counts[epoch]
+	if !ok {
+		headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head state")
+		}
+		activeCount, err := corehelpers.ActiveValidatorCount(ctx, headState, epoch)
+		if err != nil {
+			return errors.Wrap(err, "could not get active validator count")
+		}
+		count = corehelpers.SlotCommitteeCount(activeCount)
+		counts[epoch] = count
+	}
+	index := att.GetData().CommitteeIndex
+	if att.Version() >= version.Electra {
+		committees := att.CommitteeBitsVal().BitIndices()
+		if len(committees) == 0 {
+			return errors.New("no committee bit is set")
+		}
+		index = primitives.CommitteeIndex(committees[len(committees)-1])
+	}
+	if uint64(index) >= count {
+		return fmt.Errorf("committee index %d is out of range: the committee count per slot at epoch %d is %d", index, epoch, count)
+	}
+	return nil
+}
+
+// verifyAttestationInclusionWindow returns an error when an attestation with the given slot can no longer be included
+// in a block at the current slot. Before Deneb, attestations can be included up to SLOTS_PER_EPOCH slots after their slot.
+// Starting with Deneb (EIP-7045), any attestation from the current or previous epoch can be included.
+func verifyAttestationInclusionWindow(attSlot, currentSlot primitives.Slot) error {
+	attEpoch := slots.ToEpoch(attSlot)
+	if attEpoch < params.BeaconConfig().DenebForkEpoch {
+		if attSlot+params.BeaconConfig().SlotsPerEpoch < currentSlot {
+			return fmt.Errorf("attestation slot %d is more than %d slots older than current slot %d", attSlot, params.BeaconConfig().SlotsPerEpoch, currentSlot)
+		}
+		return nil
+	}
+	if currentEpoch := slots.ToEpoch(currentSlot); attEpoch+1 < currentEpoch {
+		return fmt.Errorf("attestation epoch %d is older than the previous epoch of current epoch %d", attEpoch, currentEpoch)
+	}
+	return nil
+}
+
+// verificationHeadState returns the head state needed for strict verification, or nil for lower verification levels.
+func (s *Server) verificationHeadState(ctx context.Context, level core.VerificationLevel) (state.ReadOnlyBeaconState, error) {
+	if level != core.VerificationLevelStrict {
+		return nil, nil
+	}
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	return headState, nil
+}
+
+// attestationResultFunc is invoked with the outcome of a single submitted attestation as soon as it is known.
+type attestationResultFunc func(result *structs.AttestationSubmissionStatus)
+
+// handleAttestations validates, broadcasts and pools the submitted attestations of the given fork version.
+// When onResult is not nil, it is invoked for every attestation as soon as its validation fails
+// or its broadcast completes, so that callers can report results before the whole batch is processed.
+func (s *Server) handleAttestations(
+	ctx context.Context,
+	v int,
+	data json.RawMessage,
+	level core.VerificationLevel,
+	deferBroadcast bool,
+	onResult attestationResultFunc,
+) (
+	attFailures []*server.IndexedVerificationFailure,
+	failedBroadcasts []string,
+	broadcasts []attestationBroadcast,
+	statuses []*structs.AttestationSubmissionStatus,
+	err error,
+) {
+	atts, conversionErrs, err := decodeAttestations(data, v)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(err, "failed to unmarshal attestation")
+	}
+
+	if len(atts) == 0 {
+		return nil, nil, nil, nil, errors.New("no data submitted: attestations array is empty")
+	}
+
+	headState, err := s.verificationHeadState(ctx, level)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	currentSlot := s.GenesisTimeFetcher.CurrentSlot()
+	committeeCounts := make(map[primitives.Epoch]uint64)
+
+	report := func(result *structs.AttestationSubmissionStatus) {
+		if onResult != nil {
+			onResult(result)
+		}
+	}
+	fail := func(index int, message string) {
+		attFailures = append(attFailures, &server.IndexedVerificationFailure{Index: index, Message: message})
+		report(&structs.AttestationSubmissionStatus{Index: strconv.Itoa(index), Status: "invalid", Message: message})
+	}
+
+	var validIndices []int
+	var validAttestations []eth.Att
+	for i, att := range atts {
+		if message := s.attestationValidationFailure(ctx, att, conversionErrs[i], currentSlot, committeeCounts); message != "" {
+			fail(i, message)
+			continue
+		}
+		if err = verifyAttestation(ctx, headState, att, level); err != nil {
+			fail(i, "Incorrect attestation signature: "+err.Error())
+			continue
+		}
+		validAttestations = append(validAttestations, att)
+		validIndices = append(validIndices, i)
+	}
+
+	// Broadcast the unaggregated attestations on a feed in a single event to notify other services in the beacon node
+	// of received unaggregated attestations.
+	// Note we can't send for aggregated att because we don't have selection proof.
+	// Aggregates submitted along with their selection proof through SubmitAggregateAndProofs are sent there.
+	unaggregatedAtts := make([]eth.Att, 0, len(validAttestations))
+	for _, att := range validAttestations {
+		if !corehelpers.IsAggregated(att) {
+			unaggregatedAtts = append(unaggregatedAtts, att)
+		}
+	}
+	if len(unaggregatedAtts) > 0 {
+		s.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.UnaggregatedAttsReceived,
+			Data: &operation.UnAggregatedAttsReceivedData{
+				Attestations: unaggregatedAtts,
+			},
+		})
+	}
+
+	failBroadcast := func(i int, subnet *uint64, err error) {
+		failedBroadcasts = append(failedBroadcasts, strconv.Itoa(validIndices[i]))
+		s.recordAttestationBroadcastFailure(validIndices[i], validAttestations[i], subnet, err)
+		report(&structs.AttestationSubmissionStatus{
+			Index:            strconv.Itoa(validIndices[i]),
+			Status:           "broadcast_failed",
+			Message:          err.Error(),
+			BroadcastFailure: s.handleAttestationBroadcastFailure(validAttestations[i]),
+		})
+	}
+	for i, att := range validAttestations {
+		if deferBroadcast {
+			status := attestationSubmissionStatus(validIndices[i], s.saveAttestationToPool(att))
+			statuses = append(statuses, status)
+			report(status)
+			continue
+		}
+
+		wantedEpoch := slots.ToEpoch(att.GetData().Slot)
+		vals, err := s.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
+		if err != nil {
+			failBroadcast(i, nil, err)
+			continue
+		}
+		committeeIndex, err := att.GetCommitteeIndex()
+		if err != nil {
+			failBroadcast(i, nil, err)
+			continue
+		}
+
+		subnet := corehelpers.ComputeSubnetFromCommitteeAndSlot(uint64(len(vals)), committeeIndex, att.GetData().Slot)
+		if err = s.Broadcaster.BroadcastAttestation(ctx, subnet, att); err != nil {
+			logErrorRateLimited(logrus.Fields{"index": validIndices[i], "subnet": subnet}, err, "could not broadcast attestation")
+			failBroadcast(i, &subnet, err)
+			continue
+		}
+		broadcasts = append(broadcasts, attestationBroadcast{index: validIndices[i], subnet: subnet})
+
+		status := attestationSubmissionStatus(validIndices[i], s.saveAttestationToPool(att))
+		statuses = append(statuses, status)
+		report(status)
+	}
+
+	return attFailures, failedBroadcasts, broadcasts, statuses, nil
+}
+
+// decodeAttestations decodes the JSON attestations of the given fork version and converts them to consensus
+// attestations. The conversion error of every attestation is returned at its index, so that the remaining
+// attestations can still be processed.
+func decodeAttestations(data json.RawMessage, v int) ([]eth.Att, []error, error) {
+	var atts []eth.Att
+	var conversionErrs []error
+	if v >= version.Electra {
+		var sourceAttestations []*structs.AttestationElectra
+		if err := json.Unmarshal(data, &sourceAttestations); err != nil {
+			return nil, nil, err
+		}
+		for _, sourceAtt := range sourceAttestations {
+			att, err := sourceAtt.ToConsensus()
+			atts = append(atts, att)
+			conversionErrs = append(conversionErrs, err)
+		}
+	} else {
+		var sourceAttestations []*structs.Attestation
+		if err := json.Unmarshal(data, &sourceAttestations); err != nil {
+			return nil, nil, err
+		}
+		for _, sourceAtt := range sourceAttestations {
+			att, err := sourceAtt.ToConsensus()
+			atts = append(atts, att)
+			conversionErrs = append(conversionErrs, err)
+		}
+	}
+	return atts, conversionErrs, nil
+}
+
+// attestationValidationFailure runs the checks applied to every submitted attestation, except for the verification
+// of signatures, and returns the message describing the first failed check. An empty message is returned when the
+// attestation passes all checks. conversionErr is the error of converting the submitted attestation, if any.
+func (s *Server) attestationValidationFailure(
+	ctx context.Context,
+	att eth.Att,
+	conversionErr error,
+	currentSlot primitives.Slot,
+	committeeCounts map[primitives.Epoch]uint64,
+) string {
+	if conversionErr != nil {
+		return "Could not convert request attestation to consensus attestation: " + conversionErr.Error()
+	}
+	if err := verifyAttestationRoots(att.GetData()); err != nil {
+		return "Zero attestation root: " + err.Error()
+	}
+	if err := verifyAttestationEpochs(att.GetData()); err != nil {
+		return "Inconsistent attestation epochs: " + err.Error()
+	}
+	if err := verifyAttestationInclusionWindow(att.GetData().Slot, currentSlot); err != nil {
+		return "Attestation is too old: " + err.Error()
+	}
+	if err := s.verifyAttestationSource(att.GetData()); err != nil {
+		return "Invalid attestation source: " + err.Error()
+	}
+	if err := s.verifyAttestationCommittee(ctx, att, committeeCounts); err != nil {
+		return "Invalid attestation committee: " + err.Error()
+	}
+	return ""
+}
+
+// saveAttestationToPool saves the attestation to the aggregated or unaggregated attestation pool.
+// It returns true if the attestation was not already known to the pool.
+func (s *Server) saveAttestationToPool(att eth.Att) bool {
+	if corehelpers.IsAggregated(att) {
+		added, err := s.AttestationsPool.SaveAggregatedAttestation(att)
+		if err != nil {
+			logErrorRateLimited(nil, err, "could not save aggregated attestation")
+		}
+		if added {
+			s.saveRPCAttestationSource(att)
+		}
+		return added
+	}
+	added, err := s.AttestationsPool.SaveUnaggregatedAttestation(att)
+	if err != nil {
+		logErrorRateLimited(nil, err, "could not save unaggregated attestation")
+	}
+	if added {
+		s.saveRPCAttestationSource(att)
+	}
+	return added
+}
+
+// saveRPCAttestationSource records in the pool that the attestation was submitted through this API,
+// when the EnableAttestationSourceTagging feature is enabled.
+func (s *Server) saveRPCAttestationSource(att eth.Att) {
+	if !features.Get().EnableAttestationSourceTagging {
+		return
+	}
+	if err := s.AttestationsPool.SaveRPCAttestationSource(att); err != nil {
+		logErrorRateLimited(nil, err, "could not save attestation source")
+	}
+}
+
+// attestationSubmissionStatus reports whether the attestation at the given request index was newly added to the pool.
+func attestationSubmissionStatus(index int, added bool) *structs.AttestationSubmissionStatus {
+	status := "duplicate"
+	if added {
+		status = "new"
+	}
+	return &structs.AttestationSubmissionStatus{
+		Index:  strconv.Itoa(index),
+		Status: status,
+	}
+}
+
+// attestationSubmissionHasDetails reports whether the response of a fully successful submission has anything to report
+// beyond its status code. Newly pooled attestations that were broadcast are implied by the status code alone.
+func attestationSubmissionHasDetails(statuses []*structs.AttestationSubmissionStatus, deferBroadcast bool) bool {
+	if deferBroadcast {
+		return true
+	}
+	for _, status := range statuses {
+		if status.Status != "new" {
+			return true
+		}
+	}
+	return false
+}
+
+// handleAttestationBroadcastFailure saves an attestation that could not be broadcast to the pool, from which it can
+// be re-broadcast later, when the SaveBroadcastFailedAttestations feature is enabled. Otherwise the attestation is dropped.
+// It returns the outcome reported for the attestation.
+func (s *Server) handleAttestationBroadcastFailure(att eth.Att) string {
+	if !features.Get().SaveBroadcastFailedAttestations {
+		return broadcastFailureDropped
+	}
+	s.saveAttestationToPool(att)
+	return broadcastFailureRetained
+}
+
+// writeAttestationSubmissionFailures writes the response of a submission in which some attestations failed validation
+// or could not be broadcast, and returns false without writing anything when every attestation succeeded.
+// When other attestations were broadcast or, with a deferred broadcast, saved to the pool, a 202 reports them
+// along with the failures and the aggregates produced from them, if any. Otherwise, broadcast failures result
+// in a 500 and validation failures in a 400.
+func writeAttestationSubmissionFailures(
+	w http.ResponseWriter,
+	attFailures []*server.IndexedVerificationFailure,
+	failedBroadcasts []string,
+	statuses []*structs.AttestationSubmissionStatus,
+	deferBroadcast bool,
+	receiptID string,
+	aggregates []*structs.SubmittedAttestationsAggregate,
+) bool {
+	if len(attFailures) == 0 && len(failedBroadcasts) == 0 {
+		return false
+	}
+	if len(statuses) > 0 {
+		var messages []string
+		if len(attFailures) > 0 {
+			messages = append(messages, "Some attestations failed validation")
+		}
+		if len(failedBroadcasts) > 0 {
+			messages = append(messages, attestationBroadcastFailuresMessage(failedBroadcasts))
+		}
+		httputil.WriteJsonWithStatus(w, http.StatusAccepted, &structs.SubmitAttestationsPartialResponse{
+			Message:           strings.Join(messages, "; "),
+			Accepted:          strconv.Itoa(len(statuses)),
+			BroadcastDeferred: deferBroadcast,
+			Statuses:          statuses,
+			Failures:          attFailures,
+			FailedBroadcasts:  failedBroadcasts,
+			ReceiptID:         receiptID,
+			Aggregates:        aggregates,
+		})
+		return true
+	}
+	if len(failedBroadcasts) > 0 {
+		writeAttestationBroadcastFailures(w, failedBroadcasts)
+		return true
+	}
+	httputil.WriteError(w, &server.IndexedVerificationFailureError{
+		Code:     http.StatusBadRequest,
+		Message:  "One or more attestations failed validation",
+		Failures: attFailures,
+	})
+	return true
+}
+
+// writeAttestationBroadcastFailures writes the error returned when some attestations could not be broadcast.
+// The error states whether these attestations were saved to the pool or dropped.
+func writeAttestationBroadcastFailures(w http.ResponseWriter, failedBroadcasts []string) {
+	httputil.HandleError(w, attestationBroadcastFailuresMessage(failedBroadcasts), http.StatusInternalServerError)
+}
+
+// attestationBroadcastFailuresMessage describes the attestations that could not be broadcast
+// and whether they were saved to the pool or dropped.
+func attestationBroadcastFailuresMessage(failedBroadcasts []string) string {
+	outcome := "dropped"
+	if features.Get().SaveBroadcastFailedAttestations {
+		outcome = "saved to the pool"
+	}
+	return fmt.Sprintf("Attestations at index %s could not be broadcasted and were %s", strings.Join(failedBroadcasts, ", "), outcome)
+}
+
+// recordAttestationBroadcastFailure records an attestation that could not be broadcast.
+// A nil subnet means that the failure happened before the subnet could be computed.
+func (s *Server) recordAttestationBroadcastFailure(index int, att eth.Att, subnet *uint64, broadcastErr error) {
+	f := BroadcastFailure{
+		Operation: "attestation",
+		Index:     index,
+		Err:       broadcastErr.Error(),
+		Time:      time.Now(),
+	}
+	if subnet != nil {
+		f.Subnet = *subnet
+		f.HasSubnet = true
+	}
+	root, err := att.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Error("could not compute attestation root")
+	}
+	f.Root = root
+	s.BroadcastFailures.Record(f)
+}
+
+// attestationBroadcastDeferred reports whether submitted attestations should only be pooled
+// because the node is connected to fewer peers than the configured minimum.
+func (s *Server) attestationBroadcastDeferred() bool {
+	if s.MinAttestationBroadcastPeers == 0 || s.PeersFetcher == nil {
+		return false
+	}
+	return uint64(len(s.PeersFetcher.Peers().Connected())) < s.MinAttestationBroadcastPeers
+}
+
+// ListVoluntaryExits retrieves voluntary exits known by the node but
+// not necessarily incorporated into any block.
+// When `include_ssz=true` is passed, every exit carries an additional base64-encoded `ssz` field,
+// which roughly doubles the size of the response.
+// The `validator_index` query parameter, which can be repeated or hold comma-separated values,
+// restricts the result to the exits of the given validators.
+func (s *Server) ListVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.ListVoluntaryExits")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	includeSSZ, ok := shared.BoolFromQuery(w, r, "include_ssz")
+	if !ok {
+		return
+	}
+	validatorIndices, ok := shared.UintsFromQuery(w, r, "validator_index")
+	if !ok {
+		return
+	}
+
+	sourceExits, err := s.VoluntaryExitsPool.PendingExits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get exits from the pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.PoolSizeHeader, strconv.Itoa(len(sourceExits)))
+	if len(validatorIndices) > 0 {
+		requested := make(map[primitives.ValidatorIndex]struct{}, len(validatorIndices))
+		for _, idx := range validatorIndices {
+			requested[primitives.ValidatorIndex(idx)] = struct{}{}
+		}
+		filtered := make([]*eth.SignedVoluntaryExit, 0, len(sourceExits))
+		for _, e := range sourceExits {
+			if _, ok := requested[e.Exit.ValidatorIndex]; ok {
+				filtered = append(filtered, e)
+			}
+		}
+		sourceExits = filtered
+	}
+
+	if includeSSZ {
+		exits := make([]*structs.SignedVoluntaryExitWithSSZ, len(sourceExits))
+		for i, e := range sourceExits {
+			sszBytes, err := e.MarshalSSZ()
+			if err != nil {
+				httputil.HandleError(w, "Could not marshal exit to SSZ: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			exits[i] = &structs.SignedVoluntaryExitWithSSZ{
+				SignedVoluntaryExit: structs.SignedExitFromConsensus(e),
+				SSZ:                 base64.StdEncoding.EncodeToString(sszBytes),
+			}
+		}
+		httputil.WriteJson(w, &structs.ListVoluntaryExitsWithSSZResponse{Data: exits})
+		return
+	}
+
+	exits := make([]*structs.SignedVoluntaryExit, len(sourceExits))
+	for i, e := range sourceExits {
+		exits[i] = structs.SignedExitFromConsensus(e)
+	}
+
+	httputil.WriteJson(w, &structs.ListVoluntaryExitsResponse{Data: exits})
+}
+
+// SubmitVoluntaryExit submits a SignedVoluntaryExit object to node's pool
+// and if passes validation node MUST broadcast it to network.
+// The exit may be submitted as JSON or, with `Content-Type: application/octet-stream`, as SSZ.
+// Passing `broadcast=false` verifies the exit and stages it in the pool without broadcasting it,
+// which allows operators to stage exits on the node ahead of time. Staged exits are not included in blocks
+// proposed by this node. Submitting the exit again without `broadcast=false` releases and broadcasts it.
+func (s *Server) SubmitVoluntaryExit(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitVoluntaryExit")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	broadcast := true
+	if r.URL.Query().Get("broadcast") != "" {
+		var ok bool
+		broadcast, ok = shared.BoolFromQuery(w, r, "broadcast")
+		if !ok {
+			return
+		}
+	}
+
+	var exit *eth.SignedVoluntaryExit
+	var ok bool
+	if httputil.IsRequestSsz(r) {
+		exit, ok = decodeVoluntaryExitSSZ(w, r)
+	} else {
+		exit, ok = decodeVoluntaryExitJSON(w, r)
+	}
+	if !ok {
 		return
 	}
 
-	if len(failedBroadcasts) > 0 {
-		httputil.HandleError(
-			w,
-			fmt.Sprintf("Attestations at index %s could not be broadcasted", strings.Join(failedBroadcasts, ", ")),
-			http.StatusInternalServerError,
-		)
+	if errJson := s.verifyVoluntaryExit(ctx, exit); errJson != nil {
+		httputil.WriteError(w, errJson)
 		return
 	}
 
-	if len(attFailures) > 0 {
-		failuresErr := &server.IndexedVerificationFailureError{
-			Code:     http.StatusBadRequest,
-			Message:  "One or more attestations failed validation",
-			Failures: attFailures,
-		}
-		httputil.WriteError(w, failuresErr)
+	if !broadcast {
+		s.VoluntaryExitsPool.StageVoluntaryExit(exit)
+		return
+	}
+	s.VoluntaryExitsPool.InsertVoluntaryExit(exit)
+	if err := s.Broadcaster.Broadcast(ctx, exit); err != nil {
+		httputil.HandleError(w, "Could not broadcast exit: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
 }
 
-// SubmitAttestationsV2 submits an attestation object to node. If the attestation passes all validation
-// constraints, node MUST publish the attestation on an appropriate subnet.
-func (s *Server) SubmitAttestationsV2(w http.ResponseWriter, r *http.Request) {
-	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestationsV2")
+// DeleteVoluntaryExit serves `DELETE /eth/v1/beacon/pool/voluntary_exits/{validator_index}`. It removes the pooled exit
+// of the validator identified by the `validator_index` path parameter and returns it, or returns a 404 when the validator
+// has no pending exit. The optional If-Match header carries the expected hash tree root of the signed exit, in which case
+// the exit is only removed if the pooled exit matches it and a 412 is returned otherwise. This allows tooling
+// that reads and then deletes exits to do so safely when other clients modify the pool concurrently.
+// This is an admin operation which is only available when the node runs with --enable-pool-exit-deletion.
+func (s *Server) DeleteVoluntaryExit(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.DeleteVoluntaryExit")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
-	versionHeader := r.Header.Get(api.VersionHeader)
-	if versionHeader == "" {
-		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
+	if !features.Get().EnablePoolExitDeletion {
+		httputil.HandleError(w, "Deleting pool exits is disabled, run the node with --"+features.EnablePoolExitDeletion.Name+" to enable it", http.StatusForbidden)
 		return
 	}
-	v, err := version.FromString(versionHeader)
-	if err != nil {
-		httputil.HandleError(w, "Invalid version: "+err.Error(), http.StatusBadRequest)
+
+	_, index, ok := shared.UintFromRoute(w, r, "validator_index")
+	if !ok {
 		return
 	}
+	var expectedRoot *[32]byte
+	if ifMatch := strings.Trim(r.Header.Get("If-Match"), `"`); ifMatch != "" && ifMatch != "*" {
+		root, err := hexutil.Decode(ifMatch)
+		if err != nil || len(root) != fieldparams.RootLength {
+			httputil.HandleError(w, "If-Match header must be a 32 byte hex encoded root", http.StatusBadRequest)
+			return
+		}
+		expectedRoot = (*[32]byte)(root)
+	}
 
-	var req structs.SubmitAttestationsRequest
-	err = json.NewDecoder(r.Body).Decode(&req.Data)
+	exit, err := s.VoluntaryExitsPool.RemoveByValidatorIndex(primitives.ValidatorIndex(index), expectedRoot)
 	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+	case errors.Is(err, voluntaryexits.ErrExitNotFound):
+		httputil.HandleError(w, fmt.Sprintf("No pending exit for validator %d", index), http.StatusNotFound)
+		return
+	case errors.Is(err, voluntaryexits.ErrExitRootMismatch):
+		httputil.HandleError(w, "Pending exit does not match the If-Match root", http.StatusPreconditionFailed)
 		return
 	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		httputil.HandleError(w, "Could not delete exit: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	httputil.WriteJson(w, &structs.DeleteVoluntaryExitResponse{Data: structs.SignedExitFromConsensus(exit)})
+}
 
-	var attFailures []*server.IndexedVerificationFailure
-	var failedBroadcasts []string
+// GetVoluntaryExitQueue reports the state of the exit queue computed from the head state: the exit churn limit,
+// the number of validators that initiated an exit which has not taken effect yet, and the earliest epoch
+// at which a voluntary exit submitted now could take effect. Before Electra the churn limit is a number of validators
+// per epoch, from Electra onwards it is an amount of gwei per epoch.
+func (s *Server) GetVoluntaryExitQueue(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetVoluntaryExitQueue")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
 
-	if v >= version.Electra {
-		attFailures, failedBroadcasts, err = s.handleAttestationsElectra(ctx, req.Data)
-	} else {
-		attFailures, failedBroadcasts, err = s.handleAttestations(ctx, req.Data)
-	}
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
-		httputil.HandleError(w, fmt.Sprintf("Failed to handle attestations: %v", err), http.StatusBadRequest)
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	currentEpoch := slots.ToEpoch(headState.Slot())
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
 
-	if len(failedBroadcasts) > 0 {
-		httputil.HandleError(
-			w,
-			fmt.Sprintf("Attestations at index %s could not be broadcasted", strings.Join(failedBroadcasts, ", ")),
-			http.StatusInternalServerError,
-		)
+	// The pre-Electra exit queue epoch is the latest exit epoch of any validator, so it is computed alongside the queue length.
+	exitQueueEpoch := corehelpers.ActivationExitEpoch(currentEpoch)
+	exitEpochs := make(map[primitives.Epoch]uint64)
+	var queued uint64
+	if err = headState.ReadFromEveryValidator(func(_ int, val state.ReadOnlyValidator) error {
+		exitEpoch := val.ExitEpoch()
+		if exitEpoch == farFutureEpoch {
+			return nil
+		}
+		exitEpochs[exitEpoch]++
+		if exitEpoch > exitQueueEpoch {
+			exitQueueEpoch = exitEpoch
+		}
+		if exitEpoch > currentEpoch {
+			queued++
+		}
+		return nil
+	}); err != nil {
+		httputil.HandleError(w, "Could not read validators: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	if len(attFailures) > 0 {
-		failuresErr := &server.IndexedVerificationFailureError{
-			Code:     http.StatusBadRequest,
-			Message:  "One or more attestations failed validation",
-			Failures: attFailures,
+	data := &structs.VoluntaryExitQueue{QueuedValidators: strconv.FormatUint(queued, 10)}
+	if headState.Version() < version.Electra {
+		activeCount, err := corehelpers.ActiveValidatorCount(ctx, headState, currentEpoch)
+		if err != nil {
+			httputil.HandleError(w, "Could not get active validator count: "+err.Error(), http.StatusInternalServerError)
+			return
 		}
-		httputil.WriteError(w, failuresErr)
+		churnLimit := corehelpers.ValidatorExitChurnLimit(activeCount)
+		if exitEpochs[exitQueueEpoch] >= churnLimit {
+			exitQueueEpoch++
+		}
+		data.ChurnLimit = strconv.FormatUint(churnLimit, 10)
+		data.EarliestExitEpoch = strconv.FormatUint(uint64(exitQueueEpoch), 10)
+	} else {
+		activeBalance, err := corehelpers.TotalActiveBalance(headState)
+		if err != nil {
+			httputil.HandleError(w, "Could not get total active balance: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		earliestExitEpoch, err := headState.EarliestExitEpoch()
+		if err != nil {
+			httputil.HandleError(w, "Could not get earliest exit epoch: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data.ChurnLimitGwei = strconv.FormatUint(uint64(corehelpers.ActivationExitChurnLimit(primitives.Gwei(activeBalance))), 10)
+		data.EarliestExitEpoch = strconv.FormatUint(uint64(max(earliestExitEpoch, corehelpers.ActivationExitEpoch(currentEpoch))), 10)
 	}
-}
 
-func (s *Server) handleAttestationsElectra(ctx context.Context, data json.RawMessage) (attFailures []*server.IndexedVerificationFailure, failedBroadcasts []string, err error) {
-	var sourceAttestations []*structs.AttestationElectra
+	httputil.WriteJson(w, &structs.GetVoluntaryExitQueueResponse{Data: data})
+}
 
-	if err = json.Unmarshal(data, &sourceAttestations); err != nil {
-		return nil, nil, errors.Wrap(err, "failed to unmarshal attestation")
+// verifyVoluntaryExit verifies the exit against the head state advanced to the exit epoch.
+// A returned error with status code 400 means that the exit is invalid, other codes indicate a failure of the node.
+// An empty or all-zero signature is rejected before any verification, as it means that the client did not sign the exit.
+func (s *Server) verifyVoluntaryExit(ctx context.Context, exit *eth.SignedVoluntaryExit) *httputil.DefaultJsonError {
+	if errJson := verifyVoluntaryExitSigned(exit); errJson != nil {
+		return errJson
 	}
+	headState, errJson := s.voluntaryExitState(ctx, exit.Exit.Epoch)
+	if errJson != nil {
+		return errJson
+	}
+	return verifyVoluntaryExitAgainstState(headState, exit)
+}
 
-	if len(sourceAttestations) == 0 {
-		return nil, nil, errors.New("no data submitted")
+// voluntaryExitState returns the head state advanced to the start of the given epoch, against which exits are verified.
+func (s *Server) voluntaryExitState(ctx context.Context, epoch primitives.Epoch) (state.BeaconState, *httputil.DefaultJsonError) {
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{Message: "Could not get head state: " + err.Error(), Code: http.StatusInternalServerError}
+	}
+	epochStart, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{Message: "Could not get epoch start: " + err.Error(), Code: http.StatusInternalServerError}
+	}
+	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, epochStart)
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{Message: "Could not process slots: " + err.Error(), Code: http.StatusInternalServerError}
 	}
+	return headState, nil
+}
 
-	var validAttestations []*eth.AttestationElectra
-	for i, sourceAtt := range sourceAttestations {
-		att, err := sourceAtt.ToConsensus()
-		if err != nil {
-			attFailures = append(attFailures, &server.IndexedVerificationFailure{
-				Index:   i,
-				Message: "Could not convert request attestation to consensus attestation: " + err.Error(),
-			})
-			continue
-		}
-		if _, err = bls.SignatureFromBytes(att.Signature); err != nil {
-			attFailures = append(attFailures, &server.IndexedVerificationFailure{
-				Index:   i,
-				Message: "Incorrect attestation signature: " + err.Error(),
-			})
-			continue
+func verifyVoluntaryExitSigned(exit *eth.SignedVoluntaryExit) *httputil.DefaultJsonError {
+	if len(exit.Signature) == 0 || bytes.Equal(exit.Signature, make([]byte, len(exit.Signature))) {
+		return &httputil.DefaultJsonError{
+			Message: "Invalid exit: missing signature, the exit must be signed by the validator",
+			Code:    http.StatusBadRequest,
 		}
-		validAttestations = append(validAttestations, att)
 	}
+	return nil
+}
 
-	for i, att := range validAttestations {
-		// Broadcast the unaggregated attestation on a feed to notify other services in the beacon node
-		// of a received unaggregated attestation.
-		// Note we can't send for aggregated att because we don't have selection proof.
-		if !corehelpers.IsAggregated(att) {
-			s.OperationNotifier.OperationFeed().Send(&feed.Event{
-				Type: operation.UnaggregatedAttReceived,
-				Data: &operation.UnAggregatedAttReceivedData{
-					Attestation: att,
-				},
-			})
+// verifyVoluntaryExitAgainstState verifies the exit against a state that is at or past the exit's epoch.
+func verifyVoluntaryExitAgainstState(headState state.ReadOnlyBeaconState, exit *eth.SignedVoluntaryExit) *httputil.DefaultJsonError {
+	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+	if err != nil {
+		if errors.Is(err, consensus_types.ErrOutOfBounds) {
+			return &httputil.DefaultJsonError{
+				Message: fmt.Sprintf(
+					"Could not get validator: validator index %d is out of bounds, the registry contains %d validators",
+					exit.Exit.ValidatorIndex,
+					headState.NumValidators(),
+				),
+				Code: http.StatusBadRequest,
+			}
 		}
+		return &httputil.DefaultJsonError{Message: "Could not get validator: " + err.Error(), Code: http.StatusInternalServerError}
+	}
+	// Report exits of validators that are already in the exit queue explicitly,
+	// so that clients can tell them apart from exits that failed verification.
+	if val.ExitEpoch() != params.BeaconConfig().FarFutureEpoch {
+		return &httputil.DefaultJsonError{Message: "Invalid exit: validator already initiated exit", Code: http.StatusBadRequest}
+	}
+	if err = blocks.VerifyExitAndSignature(val, headState, exit); err != nil {
+		return &httputil.DefaultJsonError{Message: "Invalid exit: " + err.Error(), Code: http.StatusBadRequest}
+	}
+	return nil
+}
 
-		wantedEpoch := slots.ToEpoch(att.Data.Slot)
-		vals, err := s.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
-		if err != nil {
-			failedBroadcasts = append(failedBroadcasts, strconv.Itoa(i))
+// maxVoluntaryExitFileSize bounds the size of a single file in SubmitVoluntaryExits.
+// Signed voluntary exits take up a few hundred bytes in either encoding.
+const maxVoluntaryExitFileSize = 1 << 16
+
+// SubmitVoluntaryExits submits a batch of voluntary exits, either as a JSON array of signed exits or
+// as files of a multipart form, such as a directory of pre-signed exits. In the latter case, files with the
+// `.ssz` extension are decoded as SSZ, all other files as JSON.
+// Every exit is verified in the same way as in SubmitVoluntaryExit, except that exits for an epoch after the current
+// epoch are rejected and the head state is advanced only once, to the latest epoch of the batch. Exits that pass
+// verification are pooled and broadcast, while failures are reported per exit, identified by the position of the exit
+// in the array or of the file in the form. The response has status code 500 when the node failed to verify an exit.
+func (s *Server) SubmitVoluntaryExits(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitVoluntaryExits")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	var exits []*submittedVoluntaryExit
+	var failures []*server.IndexedVerificationFailure
+	var ok bool
+	if strings.HasPrefix(r.Header.Get("Content-Type"), api.JsonMediaType) {
+		exits, failures, ok = decodeVoluntaryExitsJSON(w, r)
+	} else {
+		exits, failures, ok = decodeVoluntaryExitFiles(w, r)
+	}
+	if !ok {
+		return
+	}
+
+	currentEpoch := slots.ToEpoch(s.GenesisTimeFetcher.CurrentSlot())
+	var latestEpoch primitives.Epoch
+	toVerify := make([]*submittedVoluntaryExit, 0, len(exits))
+	for _, e := range exits {
+		if errJson := verifyVoluntaryExitSigned(e.exit); errJson != nil {
+			failures = append(failures, e.failure(errJson.Message))
 			continue
 		}
-		committeeIndex, err := att.GetCommitteeIndex()
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "failed to retrieve attestation committee index")
-		}
-		subnet := corehelpers.ComputeSubnetFromCommitteeAndSlot(uint64(len(vals)), committeeIndex, att.Data.Slot)
-		if err = s.Broadcaster.BroadcastAttestation(ctx, subnet, att); err != nil {
-			log.WithError(err).Errorf("could not broadcast attestation at index %d", i)
-			failedBroadcasts = append(failedBroadcasts, strconv.Itoa(i))
+		// The head state is advanced to the latest epoch of the batch, so a single exit for a far future epoch
+		// would make the whole batch expensive to verify.
+		if e.exit.Exit.Epoch > currentEpoch {
+			failures = append(failures, e.failure(fmt.Sprintf(
+				"Invalid exit: exit epoch %d is after the current epoch %d", e.exit.Exit.Epoch, currentEpoch,
+			)))
 			continue
 		}
+		latestEpoch = max(latestEpoch, e.exit.Exit.Epoch)
+		toVerify = append(toVerify, e)
+	}
+	var headState state.BeaconState
+	if len(toVerify) > 0 {
+		var errJson *httputil.DefaultJsonError
+		headState, errJson = s.voluntaryExitState(ctx, latestEpoch)
+		if errJson != nil {
+			httputil.WriteError(w, errJson)
+			return
+		}
+	}
 
-		if corehelpers.IsAggregated(att) {
-			if err = s.AttestationsPool.SaveAggregatedAttestation(att); err != nil {
-				log.WithError(err).Error("could not save aggregated attestation")
-			}
-		} else {
-			if err = s.AttestationsPool.SaveUnaggregatedAttestation(att); err != nil {
-				log.WithError(err).Error("could not save unaggregated attestation")
+	var failedBroadcasts []string
+	verificationErrored := false
+	for _, e := range toVerify {
+		if errJson := verifyVoluntaryExitAgainstState(headState, e.exit); errJson != nil {
+			if errJson.Code != http.StatusBadRequest {
+				log.WithError(errJson).WithField("index", e.index).Error("could not verify voluntary exit")
+				verificationErrored = true
 			}
+			failures = append(failures, e.failure(errJson.Message))
+			continue
+		}
+
+		s.VoluntaryExitsPool.InsertVoluntaryExit(e.exit)
+		if err := s.Broadcaster.Broadcast(ctx, e.exit); err != nil {
+			log.WithError(err).WithField("index", e.index).Error("could not broadcast voluntary exit")
+			failedBroadcasts = append(failedBroadcasts, strconv.Itoa(e.index))
 		}
 	}
 
-	return attFailures, failedBroadcasts, nil
+	s.recordSubmissionRejections(failures, len(failedBroadcasts))
+	if len(failedBroadcasts) > 0 {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Voluntary exits at index %s could not be broadcasted", strings.Join(failedBroadcasts, ", ")),
+			http.StatusInternalServerError,
+		)
+		return
+	}
+	if len(failures) > 0 {
+		sort.Slice(failures, func(i, j int) bool { return failures[i].Index < failures[j].Index })
+		failuresErr := &server.IndexedVerificationFailureError{
+			Code:     http.StatusBadRequest,
+			Message:  "One or more voluntary exits failed validation",
+			Failures: failures,
+		}
+		if verificationErrored {
+			failuresErr.Code = http.StatusInternalServerError
+			failuresErr.Message = "One or more voluntary exits could not be verified"
+		}
+		httputil.WriteError(w, failuresErr)
+	}
 }
 
-func (s *Server) handleAttestations(ctx context.Context, data json.RawMessage) (attFailures []*server.IndexedVerificationFailure, failedBroadcasts []string, err error) {
-	var sourceAttestations []*structs.Attestation
+// submittedVoluntaryExit is a decoded exit of a SubmitVoluntaryExits batch.
+type submittedVoluntaryExit struct {
+	exit  *eth.SignedVoluntaryExit
+	index int
+	// name identifies the file of the exit in multipart requests.
+	name string
+}
 
-	if err = json.Unmarshal(data, &sourceAttestations); err != nil {
-		return nil, nil, errors.Wrap(err, "failed to unmarshal attestation")
+func (e *submittedVoluntaryExit) failure(message string) *server.IndexedVerificationFailure {
+	if e.name != "" {
+		message = e.name + ": " + message
 	}
+	return &server.IndexedVerificationFailure{Index: e.index, Message: message}
+}
 
-	if len(sourceAttestations) == 0 {
-		return nil, nil, errors.New("no data submitted")
+// decodeVoluntaryExitsJSON decodes a JSON array of signed voluntary exits. Exits that cannot be converted are reported as failures.
+func decodeVoluntaryExitsJSON(w http.ResponseWriter, r *http.Request) ([]*submittedVoluntaryExit, []*server.IndexedVerificationFailure, bool) {
+	var req []*structs.SignedVoluntaryExit
+	if !shared.DecodeJSONBody(w, r, &req) {
+		return nil, nil, false
+	}
+	if len(req) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return nil, nil, false
 	}
 
-	var validAttestations []*eth.Attestation
-	for i, sourceAtt := range sourceAttestations {
-		att, err := sourceAtt.ToConsensus()
-		if err != nil {
-			attFailures = append(attFailures, &server.IndexedVerificationFailure{
-				Index:   i,
-				Message: "Could not convert request attestation to consensus attestation: " + err.Error(),
-			})
+	exits := make([]*submittedVoluntaryExit, 0, len(req))
+	var failures []*server.IndexedVerificationFailure
+	for i, e := range req {
+		if e == nil {
+			failures = append(failures, &server.IndexedVerificationFailure{Index: i, Message: "Exit is empty"})
 			continue
 		}
-		if _, err = bls.SignatureFromBytes(att.Signature); err != nil {
-			attFailures = append(attFailures, &server.IndexedVerificationFailure{
+		exit, err := e.ToConsensus()
+		if err != nil {
+			failures = append(failures, &server.IndexedVerificationFailure{
 				Index:   i,
-				Message: "Incorrect attestation signature: " + err.Error(),
+				Message: "Could not convert request exit to consensus exit: " + err.Error(),
 			})
 			continue
 		}
-		validAttestations = append(validAttestations, att)
+		exits = append(exits, &submittedVoluntaryExit{exit: exit, index: i})
 	}
+	return exits, failures, true
+}
 
-	for i, att := range validAttestations {
-		// Broadcast the unaggregated attestation on a feed to notify other services in the beacon node
-		// of a received unaggregated attestation.
-		// Note we can't send for aggregated att because we don't have selection proof.
-		if !corehelpers.IsAggregated(att) {
-			s.OperationNotifier.OperationFeed().Send(&feed.Event{
-				Type: operation.UnaggregatedAttReceived,
-				Data: &operation.UnAggregatedAttReceivedData{
-					Attestation: att,
-				},
-			})
-		}
+// decodeVoluntaryExitFiles decodes the files of a multipart form into signed voluntary exits.
+// Files that cannot be decoded are reported as failures.
+func decodeVoluntaryExitFiles(w http.ResponseWriter, r *http.Request) ([]*submittedVoluntaryExit, []*server.IndexedVerificationFailure, bool) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		httputil.HandleError(w, "Could not read multipart request: "+err.Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
 
-		wantedEpoch := slots.ToEpoch(att.Data.Slot)
-		vals, err := s.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
+	var exits []*submittedVoluntaryExit
+	var failures []*server.IndexedVerificationFailure
+	numFiles := 0
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
 		if err != nil {
-			failedBroadcasts = append(failedBroadcasts, strconv.Itoa(i))
-			continue
+			httputil.HandleError(w, "Could not read multipart request: "+err.Error(), http.StatusBadRequest)
+			return nil, nil, false
 		}
-
-		subnet := corehelpers.ComputeSubnetFromCommitteeAndSlot(uint64(len(vals)), att.Data.CommitteeIndex, att.Data.Slot)
-		if err = s.Broadcaster.BroadcastAttestation(ctx, subnet, att); err != nil {
-			log.WithError(err).Errorf("could not broadcast attestation at index %d", i)
-			failedBroadcasts = append(failedBroadcasts, strconv.Itoa(i))
+		fileName := part.FileName()
+		if fileName == "" {
+			// Form fields other than files carry no exits.
 			continue
 		}
+		index := numFiles
+		numFiles++
 
-		if corehelpers.IsAggregated(att) {
-			if err = s.AttestationsPool.SaveAggregatedAttestation(att); err != nil {
-				log.WithError(err).Error("could not save aggregated attestation")
-			}
-		} else {
-			if err = s.AttestationsPool.SaveUnaggregatedAttestation(att); err != nil {
-				log.WithError(err).Error("could not save unaggregated attestation")
-			}
+		exit, err := decodeVoluntaryExitFile(part)
+		if err != nil {
+			failures = append(failures, &server.IndexedVerificationFailure{
+				Index:   index,
+				Message: fileName + ": " + err.Error(),
+			})
+			continue
 		}
+		exits = append(exits, &submittedVoluntaryExit{exit: exit, index: index, name: fileName})
 	}
-
-	return attFailures, failedBroadcasts, nil
+	if numFiles == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return nil, nil, false
+	}
+	return exits, failures, true
 }
 
-// ListVoluntaryExits retrieves voluntary exits known by the node but
-// not necessarily incorporated into any block.
-func (s *Server) ListVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	_, span := trace.StartSpan(r.Context(), "beacon.ListVoluntaryExits")
-	defer span.End()
-
-	sourceExits, err := s.VoluntaryExitsPool.PendingExits()
+// decodeVoluntaryExitFile decodes a signed voluntary exit from a file of a multipart form.
+func decodeVoluntaryExitFile(part *multipart.Part) (*eth.SignedVoluntaryExit, error) {
+	body, err := io.ReadAll(io.LimitReader(part, maxVoluntaryExitFileSize+1))
 	if err != nil {
-		httputil.HandleError(w, "Could not get exits from the pool: "+err.Error(), http.StatusInternalServerError)
-		return
+		return nil, errors.Wrap(err, "could not read file")
 	}
-	exits := make([]*structs.SignedVoluntaryExit, len(sourceExits))
-	for i, e := range sourceExits {
-		exits[i] = structs.SignedExitFromConsensus(e)
+	if len(body) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if len(body) > maxVoluntaryExitFileSize {
+		return nil, fmt.Errorf("file exceeds %d bytes", maxVoluntaryExitFileSize)
 	}
 
-	httputil.WriteJson(w, &structs.ListVoluntaryExitsResponse{Data: exits})
+	// The file extension decides the encoding, as form files are commonly uploaded as application/octet-stream regardless of their content.
+	if strings.EqualFold(filepath.Ext(part.FileName()), ".ssz") {
+		exit := &eth.SignedVoluntaryExit{}
+		if err = exit.UnmarshalSSZ(body); err != nil {
+			return nil, errors.Wrap(err, "could not decode file into consensus exit")
+		}
+		return exit, nil
+	}
+	var req structs.SignedVoluntaryExit
+	if err = json.Unmarshal(body, &req); err != nil {
+		return nil, errors.Wrap(err, "could not decode file")
+	}
+	exit, err := req.ToConsensus()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert file exit to consensus exit")
+	}
+	return exit, nil
 }
 
-// SubmitVoluntaryExit submits a SignedVoluntaryExit object to node's pool
-// and if passes validation node MUST broadcast it to network.
-func (s *Server) SubmitVoluntaryExit(w http.ResponseWriter, r *http.Request) {
-	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitVoluntaryExit")
-	defer span.End()
-
+func decodeVoluntaryExitJSON(w http.ResponseWriter, r *http.Request) (*eth.SignedVoluntaryExit, bool) {
 	var req structs.SignedVoluntaryExit
-	err := json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
+	if !shared.DecodeJSONBody(w, r, &req) {
+		return nil, false
 	}
 
 	exit, err := req.ToConsensus()
 	if err != nil {
 		httputil.HandleError(w, "Could not convert request exit to consensus exit: "+err.Error(), http.StatusBadRequest)
-		return
+		return nil, false
 	}
+	return exit, true
+}
 
-	headState, err := s.ChainInfoFetcher.HeadState(ctx)
-	if err != nil {
-		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	epochStart, err := slots.EpochStart(exit.Exit.Epoch)
-	if err != nil {
-		httputil.HandleError(w, "Could not get epoch start: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, epochStart)
-	if err != nil {
-		httputil.HandleError(w, "Could not process slots: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+func decodeVoluntaryExitSSZ(w http.ResponseWriter, r *http.Request) (*eth.SignedVoluntaryExit, bool) {
+	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		if errors.Is(err, consensus_types.ErrOutOfBounds) {
-			httputil.HandleError(w, "Could not get validator: "+err.Error(), http.StatusBadRequest)
-			return
-		}
-		httputil.HandleError(w, "Could not get validator: "+err.Error(), http.StatusInternalServerError)
-		return
+		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
 	}
-	if err = blocks.VerifyExitAndSignature(val, headState, exit); err != nil {
-		httputil.HandleError(w, "Invalid exit: "+err.Error(), http.StatusBadRequest)
-		return
+	if len(body) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return nil, false
 	}
-
-	s.VoluntaryExitsPool.InsertVoluntaryExit(exit)
-	if err = s.Broadcaster.Broadcast(ctx, exit); err != nil {
-		httputil.HandleError(w, "Could not broadcast exit: "+err.Error(), http.StatusInternalServerError)
-		return
+	exit := &eth.SignedVoluntaryExit{}
+	if err = exit.UnmarshalSSZ(body); err != nil {
+		httputil.HandleError(w, "Could not decode request body into consensus exit: "+err.Error(), http.StatusBadRequest)
+		return nil, false
 	}
+	return exit, true
 }
 
 // SubmitSyncCommitteeSignatures submits sync committee signature objects to the node.
+// A message for the same validator, slot and block root as an earlier message of the request is only submitted once.
+// When the request holds such duplicates and no message fails validation, the response lists them,
+// along with the index of the message they duplicate.
 func (s *Server) SubmitSyncCommitteeSignatures(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitPoolSyncCommitteeSignatures")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	var req structs.SubmitSyncCommitteeSignaturesRequest
-	err := json.NewDecoder(r.Body).Decode(&req.Data)
-	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+	if !shared.DecodeJSONBody(w, r, &req.Data) {
 		return
 	}
 	if len(req.Data) == 0 {
@@ -521,6 +3610,13 @@ func (s *Server) SubmitSyncCommitteeSignatures(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	type messageKey struct {
+		validatorIndex primitives.ValidatorIndex
+		slot           primitives.Slot
+		blockRoot      [32]byte
+	}
+	seen := make(map[messageKey]int)
+	var duplicates []*structs.SyncCommitteeMessageDuplicate
 	var validMessages []*eth.SyncCommitteeMessage
 	var msgFailures []*server.IndexedVerificationFailure
 	for i, sourceMsg := range req.Data {
@@ -532,6 +3628,23 @@ func (s *Server) SubmitSyncCommitteeSignatures(w http.ResponseWriter, r *http.Re
 			})
 			continue
 		}
+		// Messages voting for blocks the node has never seen would only waste verification in the core service.
+		if !s.FinalizationFetcher.InForkchoice(bytesutil.ToBytes32(msg.BlockRoot)) {
+			msgFailures = append(msgFailures, &server.IndexedVerificationFailure{
+				Index:   i,
+				Message: fmt.Sprintf("Block root %#x is not known to fork choice", msg.BlockRoot),
+			})
+			continue
+		}
+		key := messageKey{validatorIndex: msg.ValidatorIndex, slot: msg.Slot, blockRoot: bytesutil.ToBytes32(msg.BlockRoot)}
+		if first, ok := seen[key]; ok {
+			duplicates = append(duplicates, &structs.SyncCommitteeMessageDuplicate{
+				Index:       strconv.Itoa(i),
+				DuplicateOf: strconv.Itoa(first),
+			})
+			continue
+		}
+		seen[key] = i
 		validMessages = append(validMessages, msg)
 	}
 
@@ -542,6 +3655,7 @@ func (s *Server) SubmitSyncCommitteeSignatures(w http.ResponseWriter, r *http.Re
 		}
 	}
 
+	s.recordSubmissionRejections(msgFailures, 0)
 	if len(msgFailures) > 0 {
 		failuresErr := &server.IndexedVerificationFailureError{
 			Code:     http.StatusBadRequest,
@@ -549,30 +3663,32 @@ func (s *Server) SubmitSyncCommitteeSignatures(w http.ResponseWriter, r *http.Re
 			Failures: msgFailures,
 		}
 		httputil.WriteError(w, failuresErr)
+		return
+	}
+	if len(duplicates) > 0 {
+		httputil.WriteJson(w, &structs.SubmitSyncCommitteeSignaturesResponse{Duplicates: duplicates})
 	}
 }
 
 // SubmitBLSToExecutionChanges submits said object to the node's pool
 // if it passes validation the node must broadcast it to the network.
+// Changes are broadcast in rate-limited batches. The changes to broadcast are assigned a submission ID,
+// returned in the X-Submission-Id header, which identifies the BLSToExecutionChangesBroadcast events
+// reporting the progress of their broadcast.
 func (s *Server) SubmitBLSToExecutionChanges(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitBLSToExecutionChanges")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 	st, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
 		httputil.HandleError(w, fmt.Sprintf("Could not get head state: %v", err), http.StatusInternalServerError)
 		return
 	}
 	var failures []*server.IndexedVerificationFailure
-	var toBroadcast []*eth.SignedBLSToExecutionChange
-
-	var req []*structs.SignedBLSToExecutionChange
-	err = json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+	var toBroadcast []submittedBLSChange
+
+	var req []*structs.SignedBLSToExecutionChange
+	if !shared.DecodeJSONBody(w, r, &req) {
 		return
 	}
 	if len(req) == 0 {
@@ -589,7 +3705,26 @@ func (s *Server) SubmitBLSToExecutionChanges(w http.ResponseWriter, r *http.Requ
 			})
 			continue
 		}
+		if uint64(sbls.Message.ValidatorIndex) >= uint64(st.NumValidators()) {
+			failures = append(failures, &server.IndexedVerificationFailure{
+				Index: i,
+				Message: fmt.Sprintf(
+					"Could not validate SignedBLSToExecutionChange: validator index %d is out of bounds, the registry contains %d validators",
+					sbls.Message.ValidatorIndex,
+					st.NumValidators(),
+				),
+			})
+			continue
+		}
 		_, err = blocks.ValidateBLSToExecutionChange(st, sbls)
+		if errors.Is(err, blocks.ErrInvalidWithdrawalCredentials) {
+			// The most common mistake when constructing a change, so it gets a message that names the faulty field.
+			failures = append(failures, &server.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not validate SignedBLSToExecutionChange: from_bls_pubkey does not match validator's withdrawal credentials",
+			})
+			continue
+		}
 		if err != nil {
 			failures = append(failures, &server.IndexedVerificationFailure{
 				Index:   i,
@@ -600,7 +3735,7 @@ func (s *Server) SubmitBLSToExecutionChanges(w http.ResponseWriter, r *http.Requ
 		if err := blocks.VerifyBLSChangeSignature(st, sbls); err != nil {
 			failures = append(failures, &server.IndexedVerificationFailure{
 				Index:   i,
-				Message: "Could not validate signature: " + err.Error(),
+				Message: "Could not validate signature: " + err.Error() + ". " + blsChangeDomainHint(st),
 			})
 			continue
 		}
@@ -612,10 +3747,15 @@ func (s *Server) SubmitBLSToExecutionChanges(w http.ResponseWriter, r *http.Requ
 		})
 		s.BLSChangesPool.InsertBLSToExecChange(sbls)
 		if st.Version() >= version.Capella {
-			toBroadcast = append(toBroadcast, sbls)
+			toBroadcast = append(toBroadcast, submittedBLSChange{index: i, change: sbls})
 		}
 	}
-	go s.broadcastBLSChanges(ctx, toBroadcast)
+	if len(toBroadcast) > 0 {
+		submissionID := strconv.FormatUint(rand.NewGenerator().Uint64(), 16)
+		w.Header().Set(api.SubmissionIDHeader, submissionID)
+		go s.broadcastBLSChanges(ctx, submissionID, toBroadcast)
+	}
+	s.recordSubmissionRejections(failures, 0)
 	if len(failures) > 0 {
 		failuresErr := &server.IndexedVerificationFailureError{
 			Code:     http.StatusBadRequest,
@@ -626,47 +3766,120 @@ func (s *Server) SubmitBLSToExecutionChanges(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// broadcastBLSBatch broadcasts the first `broadcastBLSChangesRateLimit` messages from the slice pointed to by ptr.
+// blsChangeDomainHint describes the signing domain expected for BLS to execution changes. Per the spec the domain
+// is always computed with the genesis fork version, regardless of the fork the chain is currently on, which is a
+// common source of signing mistakes in wallets.
+func blsChangeDomainHint(st state.ReadOnlyBeaconState) string {
+	c := params.BeaconConfig()
+	gvr := st.GenesisValidatorsRoot()
+	domain, err := signing.ComputeDomain(c.DomainBLSToExecutionChange, c.GenesisForkVersion, gvr)
+	if err != nil {
+		return "Could not compute the expected signing domain: " + err.Error()
+	}
+	return fmt.Sprintf(
+		"BLS to execution changes must be signed with domain %#x, computed from DOMAIN_BLS_TO_EXECUTION_CHANGE %#x, genesis fork version %#x and genesis validators root %#x, independent of the current fork",
+		domain,
+		c.DomainBLSToExecutionChange,
+		c.GenesisForkVersion,
+		gvr,
+	)
+}
+
+// submittedBLSChange is a BLS to execution change waiting to be broadcast, along with its index in the submission.
+type submittedBLSChange struct {
+	index  int
+	change *eth.SignedBLSToExecutionChange
+}
+
+// broadcastBLSBatch broadcasts the first BLSChangesBroadcastRateLimit messages from the slice pointed to by ptr.
 // It validates the messages again because they could have been invalidated by being included in blocks since the last validation.
 // It removes the messages from the slice and modifies it in place.
-func (s *Server) broadcastBLSBatch(ctx context.Context, ptr *[]*eth.SignedBLSToExecutionChange) {
-	limit := broadcastBLSChangesRateLimit
-	if len(*ptr) < broadcastBLSChangesRateLimit {
+// Once the batch is processed, a BLSToExecutionChangesBroadcast event identified by the submission ID is sent
+// on the operation feed so that subscribers can follow the progress of the submission. The event reports
+// the changes that were broadcast, the ones dropped by the validation and the ones whose broadcast failed.
+func (s *Server) broadcastBLSBatch(ctx context.Context, submissionID string, ptr *[]submittedBLSChange) {
+	limit := s.BLSChangesBroadcastRateLimit
+	if limit <= 0 {
+		limit = defaultBLSChangesBroadcastRateLimit
+	}
+	if len(*ptr) < limit {
 		limit = len(*ptr)
 	}
+	if limit == 0 {
+		return
+	}
 	st, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
 		log.WithError(err).Error("could not get head state")
 		return
 	}
-	for _, ch := range (*ptr)[:limit] {
-		if ch != nil {
+	broadcast := make([]*eth.SignedBLSToExecutionChange, 0, limit)
+	var dropped, failed []*eth.SignedBLSToExecutionChange
+	for _, submitted := range (*ptr)[:limit] {
+		if ch := submitted.change; ch != nil {
 			_, err := blocks.ValidateBLSToExecutionChange(st, ch)
 			if err != nil {
-				log.WithError(err).Error("could not validate BLS to execution change")
+				logErrorRateLimited(nil, err, "could not validate BLS to execution change")
+				dropped = append(dropped, ch)
 				continue
 			}
 			if err := s.Broadcaster.Broadcast(ctx, ch); err != nil {
-				log.WithError(err).Error("could not broadcast BLS to execution changes.")
+				logErrorRateLimited(nil, err, "could not broadcast BLS to execution changes")
+				s.recordBLSChangeBroadcastFailure(submitted.index, ch, err)
+				s.SubmissionRejections.Record(RejectionCategoryBroadcast, 1)
+				failed = append(failed, ch)
+				continue
 			}
+			broadcast = append(broadcast, ch)
 		}
 	}
 	*ptr = (*ptr)[limit:]
+	s.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.BLSToExecutionChangesBroadcast,
+		Data: &operation.BLSToExecutionChangesBroadcastData{
+			SubmissionID: submissionID,
+			Changes:      broadcast,
+			Dropped:      dropped,
+			Failed:       failed,
+			Remaining:    len(*ptr),
+		},
+	})
+}
+
+// recordBLSChangeBroadcastFailure records a BLS to execution change that could not be broadcast.
+// The index is the position of the change in its submission.
+func (s *Server) recordBLSChangeBroadcastFailure(index int, ch *eth.SignedBLSToExecutionChange, broadcastErr error) {
+	root, err := ch.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Error("could not compute BLS to execution change root")
+	}
+	s.BroadcastFailures.Record(BroadcastFailure{
+		Operation: "bls_to_execution_change",
+		Index:     index,
+		Root:      root,
+		Err:       broadcastErr.Error(),
+		Time:      time.Now(),
+	})
 }
 
-func (s *Server) broadcastBLSChanges(ctx context.Context, changes []*eth.SignedBLSToExecutionChange) {
-	s.broadcastBLSBatch(ctx, &changes)
+func (s *Server) broadcastBLSChanges(ctx context.Context, submissionID string, changes []submittedBLSChange) {
+	s.broadcastBLSBatch(ctx, submissionID, &changes)
 	if len(changes) == 0 {
 		return
 	}
 
-	ticker := time.NewTicker(500 * time.Millisecond)
+	interval := s.BLSChangesBroadcastInterval
+	if interval <= 0 {
+		interval = defaultBLSChangesBroadcastInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			s.broadcastBLSBatch(ctx, &changes)
+			s.broadcastBLSBatch(ctx, submissionID, &changes)
 			if len(changes) == 0 {
 				return
 			}
@@ -678,23 +3891,64 @@ func (s *Server) broadcastBLSChanges(ctx context.Context, changes []*eth.SignedB
 func (s *Server) ListBLSToExecutionChanges(w http.ResponseWriter, r *http.Request) {
 	_, span := trace.StartSpan(r.Context(), "beacon.ListBLSToExecutionChanges")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	sourceChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
 	if err != nil {
 		httputil.HandleError(w, fmt.Sprintf("Could not get BLS to execution changes: %v", err), http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set(api.PoolSizeHeader, strconv.Itoa(len(sourceChanges)))
 
 	httputil.WriteJson(w, &structs.BLSToExecutionChangesPoolResponse{
 		Data: structs.SignedBLSChangesFromConsensus(sourceChanges),
 	})
 }
 
+// GetBLSToExecutionChangesStatus re-validates every pooled BLS to execution change against the head state and reports
+// how many of them are still valid. Changes become invalid once they, or another change for the same validator,
+// are included in a block. The pool is not modified.
+func (s *Server) GetBLSToExecutionChangesStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBLSToExecutionChangesStatus")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	changes, err := s.BLSChangesPool.PendingBLSToExecChanges()
+	if err != nil {
+		httputil.HandleError(w, "Could not get BLS to execution changes: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	st, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	valid := 0
+	invalidIndices := make([]string, 0)
+	for _, ch := range changes {
+		if _, err = blocks.ValidateBLSToExecutionChange(st, ch); err != nil {
+			invalidIndices = append(invalidIndices, strconv.FormatUint(uint64(ch.Message.ValidatorIndex), 10))
+			continue
+		}
+		valid++
+	}
+
+	httputil.WriteJson(w, &structs.GetBLSToExecutionChangesStatusResponse{
+		Data: &structs.BLSToExecutionChangesStatus{
+			Valid:                   strconv.Itoa(valid),
+			Invalid:                 strconv.Itoa(len(invalidIndices)),
+			InvalidValidatorIndices: invalidIndices,
+		},
+	})
+}
+
 // GetAttesterSlashings retrieves attester slashings known by the node but
 // not necessarily incorporated into any block.
 func (s *Server) GetAttesterSlashings(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.GetAttesterSlashings")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
@@ -721,9 +3975,18 @@ func (s *Server) GetAttesterSlashings(w http.ResponseWriter, r *http.Request) {
 
 // GetAttesterSlashingsV2 retrieves attester slashings known by the node but
 // not necessarily incorporated into any block, supporting both AttesterSlashing and AttesterSlashingElectra.
+// When `group_by=epoch` is passed, the slashings are returned as an object keyed by the target epochs of their attestations.
+// A slashing whose attestations target different epochs is listed under each of them.
 func (s *Server) GetAttesterSlashingsV2(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.GetAttesterSlashingsV2")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	groupBy := r.URL.Query().Get("group_by")
+	if groupBy != "" && groupBy != "epoch" {
+		httputil.HandleError(w, "Invalid group_by value: "+groupBy, http.StatusBadRequest)
+		return
+	}
 
 	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
@@ -754,34 +4017,60 @@ func (s *Server) GetAttesterSlashingsV2(w http.ResponseWriter, r *http.Request)
 		attStructs = append(attStructs, attStruct)
 	}
 
-	attBytes, err := json.Marshal(attStructs)
+	var attBytes []byte
+	if groupBy == "epoch" {
+		attBytes, err = json.Marshal(groupAttesterSlashingsByEpoch(sourceSlashings, attStructs))
+	} else {
+		attBytes, err = json.Marshal(attStructs)
+	}
 	if err != nil {
 		httputil.HandleError(w, fmt.Sprintf("Failed to marshal slashing: %v", err), http.StatusInternalServerError)
 		return
 	}
+	headSlot, headRoot, err := headStatePosition(ctx, headState)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head position: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	resp := &structs.GetAttesterSlashingsResponse{
-		Version: version.String(headState.Version()),
-		Data:    attBytes,
+		Version:  version.String(headState.Version()),
+		HeadSlot: headSlot,
+		HeadRoot: headRoot,
+		Data:     attBytes,
 	}
 	w.Header().Set(api.VersionHeader, version.String(headState.Version()))
 	httputil.WriteJson(w, resp)
 }
 
+// groupAttesterSlashingsByEpoch groups the converted slashings by the target epochs of the attestations
+// of the corresponding source slashings.
+func groupAttesterSlashingsByEpoch(slashings []eth.AttSlashing, attStructs []interface{}) map[string][]interface{} {
+	grouped := make(map[string][]interface{})
+	for i, slashing := range slashings {
+		first := slashing.FirstAttestation().GetData().Target.Epoch
+		second := slashing.SecondAttestation().GetData().Target.Epoch
+		key := strconv.FormatUint(uint64(first), 10)
+		grouped[key] = append(grouped[key], attStructs[i])
+		if second != first {
+			key = strconv.FormatUint(uint64(second), 10)
+			grouped[key] = append(grouped[key], attStructs[i])
+		}
+	}
+	return grouped
+}
+
 // SubmitAttesterSlashings submits an attester slashing object to node's pool and
-// if passes validation node MUST broadcast it to network.
+// if passes validation node MUST broadcast it to network. When the head state is ahead of the slashing,
+// the slot of the state used for verification is reported in the X-Verification-State-Slot header.
+// The slashing condition met by the two attestations, a double vote or a surround vote, is returned on success.
 func (s *Server) SubmitAttesterSlashings(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttesterSlashings")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	var req structs.AttesterSlashing
-	err := json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+	if !shared.DecodeJSONBody(w, r, &req) {
 		return
 	}
 
@@ -794,10 +4083,13 @@ func (s *Server) SubmitAttesterSlashings(w http.ResponseWriter, r *http.Request)
 }
 
 // SubmitAttesterSlashingsV2 submits an attester slashing object to node's pool and
-// if passes validation node MUST broadcast it to network.
+// if passes validation node MUST broadcast it to network. When the head state is ahead of the slashing,
+// the slot of the state used for verification is reported in the X-Verification-State-Slot header.
+// The slashing condition met by the two attestations, a double vote or a surround vote, is returned on success.
 func (s *Server) SubmitAttesterSlashingsV2(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttesterSlashingsV2")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	versionHeader := r.Header.Get(api.VersionHeader)
 	if versionHeader == "" {
@@ -811,13 +4103,7 @@ func (s *Server) SubmitAttesterSlashingsV2(w http.ResponseWriter, r *http.Reques
 
 	if v >= version.Electra {
 		var req structs.AttesterSlashingElectra
-		err := json.NewDecoder(r.Body).Decode(&req)
-		switch {
-		case errors.Is(err, io.EOF):
-			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-			return
-		case err != nil:
-			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		if !shared.DecodeJSONBody(w, r, &req) {
 			return
 		}
 
@@ -829,13 +4115,7 @@ func (s *Server) SubmitAttesterSlashingsV2(w http.ResponseWriter, r *http.Reques
 		s.submitAttesterSlashing(w, ctx, slashing)
 	} else {
 		var req structs.AttesterSlashing
-		err := json.NewDecoder(r.Body).Decode(&req)
-		switch {
-		case errors.Is(err, io.EOF):
-			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-			return
-		case err != nil:
-			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		if !shared.DecodeJSONBody(w, r, &req) {
 			return
 		}
 
@@ -853,16 +4133,41 @@ func (s *Server) submitAttesterSlashing(
 	ctx context.Context,
 	slashing eth.AttSlashing,
 ) {
+	// Bound the work done on oversized submissions before anything iterates over the attesting indices.
+	if err := checkAttestingIndicesCount(slashing, maxAttestingIndices(slashing.Version())); err != nil {
+		httputil.HandleError(w, "Invalid attester slashing: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Fail fast with a clear message, before the comparatively expensive state transition and signature verification.
+	slashable := slice.IntersectionUint64(
+		slashing.FirstAttestation().GetAttestingIndices(),
+		slashing.SecondAttestation().GetAttestingIndices(),
+	)
+	if len(slashable) == 0 {
+		httputil.HandleError(w, "Invalid attester slashing: no common validators between the two attestations; not slashable", http.StatusBadRequest)
+		return
+	}
+	condition, err := attesterSlashingCondition(slashing)
+	if err != nil {
+		httputil.HandleError(w, "Invalid attester slashing: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	headState, err := s.ChainInfoFetcher.HeadState(ctx)
 	if err != nil {
 		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if err = checkAttestingIndicesCount(slashing, uint64(headState.NumValidators())); err != nil {
+		httputil.HandleError(w, "Invalid attester slashing: "+err.Error(), http.StatusBadRequest)
+		return
+	}
 	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, slashing.FirstAttestation().GetData().Slot)
 	if err != nil {
 		httputil.HandleError(w, "Could not process slots: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	setVerificationStateSlotHeader(w, headState, slashing.FirstAttestation().GetData().Slot)
 
 	err = blocks.VerifyAttesterSlashing(ctx, headState, slashing)
 	if err != nil {
@@ -874,6 +4179,15 @@ func (s *Server) submitAttesterSlashing(
 		httputil.HandleError(w, "Could not insert attester slashing into pool: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if root, err := slashing.HashTreeRoot(); err != nil {
+		log.WithError(err).Error("could not compute attester slashing root")
+	} else {
+		validators := make([]primitives.ValidatorIndex, len(slashable))
+		for i, idx := range slashable {
+			validators[i] = primitives.ValidatorIndex(idx)
+		}
+		s.SubmittedSlashings.Record(root, SubmittedSlashing{Kind: SlashingKindAttester, Validators: validators})
+	}
 	// notify events
 	s.OperationNotifier.OperationFeed().Send(&feed.Event{
 		Type: operation.AttesterSlashingReceived,
@@ -887,13 +4201,77 @@ func (s *Server) submitAttesterSlashing(
 			return
 		}
 	}
+	httputil.WriteJson(w, &structs.SubmitAttesterSlashingResponse{Condition: condition})
+}
+
+// Slashing conditions met by the two attestations of an attester slashing.
+const (
+	attesterSlashingDoubleVote   = "double_vote"
+	attesterSlashingSurroundVote = "surround_vote"
+)
+
+// attesterSlashingCondition returns the slashing condition met by the two attestations of the slashing, or an error
+// naming why neither condition is met. As in the state transition, a surround vote is only accepted when the first
+// attestation surrounds the second one.
+func attesterSlashingCondition(slashing eth.AttSlashing) (string, error) {
+	data1, data2 := slashing.FirstAttestation().GetData(), slashing.SecondAttestation().GetData()
+	if data1 == nil || data2 == nil || data1.Source == nil || data2.Source == nil || data1.Target == nil || data2.Target == nil {
+		return "", errors.New("attestation data is incomplete")
+	}
+	if attestation.AttDataIsEqual(data1, data2) {
+		return "", errors.New("the two attestations have the same data; neither a double vote nor a surround vote")
+	}
+	if data1.Target.Epoch == data2.Target.Epoch {
+		return attesterSlashingDoubleVote, nil
+	}
+	if data1.Source.Epoch < data2.Source.Epoch && data2.Target.Epoch < data1.Target.Epoch {
+		return attesterSlashingSurroundVote, nil
+	}
+	if data2.Source.Epoch < data1.Source.Epoch && data1.Target.Epoch < data2.Target.Epoch {
+		return "", errors.New("the second attestation surrounds the first one; the surrounding attestation must come first")
+	}
+	return "", fmt.Errorf(
+		"neither a double vote nor a surround vote: the target epochs %d and %d differ and neither attestation surrounds the other",
+		data1.Target.Epoch,
+		data2.Target.Epoch,
+	)
+}
+
+// setVerificationStateSlotHeader reports the slot of the state used to verify an operation when it differs
+// from the slot of the operation. This happens for operations older than the head state, as slots are only
+// ever processed forward.
+func setVerificationStateSlotHeader(w http.ResponseWriter, st state.ReadOnlyBeaconState, slot primitives.Slot) {
+	if st.Slot() != slot {
+		w.Header().Set(api.VerificationStateSlotHeader, strconv.FormatUint(uint64(st.Slot()), 10))
+	}
+}
+
+// maxAttestingIndices returns the maximum number of attesting indices an indexed attestation of the given version can hold.
+func maxAttestingIndices(v int) uint64 {
+	cfg := params.BeaconConfig()
+	if v >= version.Electra {
+		return cfg.MaxValidatorsPerCommittee * cfg.MaxCommitteesPerSlot
+	}
+	return cfg.MaxValidatorsPerCommittee
+}
+
+// checkAttestingIndicesCount returns an error if either attestation of the slashing has more than limit attesting indices.
+func checkAttestingIndicesCount(slashing eth.AttSlashing, limit uint64) error {
+	for i, att := range []eth.IndexedAtt{slashing.FirstAttestation(), slashing.SecondAttestation()} {
+		if count := uint64(len(att.GetAttestingIndices())); count > limit {
+			return fmt.Errorf("attestation %d has %d attesting indices, exceeding the maximum of %d", i+1, count, limit)
+		}
+	}
+	return nil
 }
 
 // GetProposerSlashings retrieves proposer slashings known by the node
 // but not necessarily incorporated into any block.
+// With `Accept: application/octet-stream`, the slashings are returned as an SSZ-encoded list of `ProposerSlashing`.
 func (s *Server) GetProposerSlashings(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.GetProposerSlashings")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
 	if err != nil {
@@ -901,25 +4279,276 @@ func (s *Server) GetProposerSlashings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	sourceSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* return unlimited slashings */)
+	if httputil.RespondWithSsz(r) {
+		sszData, err := proposerSlashingsSSZ(sourceSlashings)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal proposer slashings into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "proposer_slashings.ssz")
+		return
+	}
 	slashings := structs.ProposerSlashingsFromConsensus(sourceSlashings)
+	headSlot, headRoot, err := headStatePosition(ctx, headState)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head position: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httputil.WriteJson(w, &structs.GetProposerSlashingsResponse{
+		HeadSlot: headSlot,
+		HeadRoot: headRoot,
+		Data:     slashings,
+	})
+}
+
+// GetSlashingStatus reports the status of a slashing submitted through SubmitAttesterSlashings,
+// SubmitAttesterSlashingsV2 or SubmitProposerSlashing, identified by its hash tree root.
+// The status is `pending` while the slashing is in the pool, `validators_slashed` once it left the pool with all
+// implicated validators slashed in the head state, and `superseded` when it left the pool while some of them are not slashed.
+// Block inclusion is not tracked: `validators_slashed` is also reported when the validators were slashed by other evidence.
+// Only a bounded number of recently submitted slashings is known, other roots result in a 404.
+func (s *Server) GetSlashingStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetSlashingStatus")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	rawRoot, rootBytes, ok := shared.HexFromRoute(w, r, "slashing_root", fieldparams.RootLength)
+	if !ok {
+		return
+	}
+	root := bytesutil.ToBytes32(rootBytes)
+	submitted, ok := s.SubmittedSlashings.Get(root)
+	if !ok {
+		httputil.HandleError(w, fmt.Sprintf("Slashing %s is not among the recently submitted slashings", rawRoot), http.StatusNotFound)
+		return
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pending, err := s.slashingPending(ctx, headState, submitted.Kind, root)
+	if err != nil {
+		httputil.HandleError(w, "Could not look up slashing in the pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	validators := make([]string, len(submitted.Validators))
+	slashed := make([]string, 0, len(submitted.Validators))
+	for i, idx := range submitted.Validators {
+		validators[i] = strconv.FormatUint(uint64(idx), 10)
+		val, err := headState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not get validator %d: %v", idx, err), http.StatusInternalServerError)
+			return
+		}
+		if val.Slashed() {
+			slashed = append(slashed, validators[i])
+		}
+	}
+
+	status := SlashingStatusSuperseded
+	switch {
+	case pending:
+		status = SlashingStatusPending
+	case len(slashed) == len(validators):
+		status = SlashingStatusValidatorsSlashed
+	}
+	httputil.WriteJson(w, &structs.GetSlashingStatusResponse{
+		Data: &structs.SlashingStatus{
+			Root:              rawRoot,
+			Kind:              submitted.Kind,
+			Status:            status,
+			Validators:        validators,
+			SlashedValidators: slashed,
+		},
+	})
+}
+
+// slashingPending returns whether the pool contains the slashing of the given kind with the given root.
+func (s *Server) slashingPending(ctx context.Context, st state.ReadOnlyBeaconState, kind string, root [32]byte) (bool, error) {
+	if kind == SlashingKindProposer {
+		for _, slashing := range s.SlashingsPool.PendingProposerSlashings(ctx, st, true /* return unlimited slashings */) {
+			r, err := slashing.HashTreeRoot()
+			if err != nil {
+				return false, err
+			}
+			if r == root {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	for _, slashing := range s.SlashingsPool.PendingAttesterSlashings(ctx, st, true /* return unlimited slashings */) {
+		r, err := slashing.HashTreeRoot()
+		if err != nil {
+			return false, err
+		}
+		if r == root {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ExportPool returns every pending operation of the node's pools in a single JSON document, served as a file download.
+// The document holds the pooled attestations, voluntary exits, BLS to execution changes, and attester and proposer
+// slashings, along with the fork version and the position of the head state. Attestations and attester slashings
+// are encoded in the format of their own fork, so that operations received around a fork transition are not lost.
+func (s *Server) ExportPool(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.ExportPool")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	headSlot, headRoot, err := headStatePosition(ctx, headState)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head position: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	pooledAtts := s.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := s.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get unaggregated attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pooledAtts = append(pooledAtts, unaggAtts...)
+	atts := make([]interface{}, len(pooledAtts))
+	for i, att := range pooledAtts {
+		switch a := att.(type) {
+		case *eth.Attestation:
+			atts[i] = structs.AttFromConsensus(a)
+		case *eth.AttestationElectra:
+			atts[i] = structs.AttElectraFromConsensus(a)
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert attestation of type %T", att), http.StatusInternalServerError)
+			return
+		}
+	}
+	exits, err := s.VoluntaryExitsPool.PendingExits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get exits from the pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	exitStructs := make([]*structs.SignedVoluntaryExit, len(exits))
+	for i, e := range exits {
+		exitStructs[i] = structs.SignedExitFromConsensus(e)
+	}
+	changes, err := s.BLSChangesPool.PendingBLSToExecChanges()
+	if err != nil {
+		httputil.HandleError(w, "Could not get BLS to execution changes from the pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attSlashings := s.SlashingsPool.PendingAttesterSlashings(ctx, headState, true /* return unlimited slashings */)
+	attesterSlashings := make([]interface{}, len(attSlashings))
+	for i, slashing := range attSlashings {
+		switch sl := slashing.(type) {
+		case *eth.AttesterSlashing:
+			attesterSlashings[i] = structs.AttesterSlashingFromConsensus(sl)
+		case *eth.AttesterSlashingElectra:
+			attesterSlashings[i] = structs.AttesterSlashingElectraFromConsensus(sl)
+		default:
+			httputil.HandleError(w, fmt.Sprintf("Unable to convert slashing of type %T", slashing), http.StatusInternalServerError)
+			return
+		}
+	}
+	proposerSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* return unlimited slashings */)
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=pool_export_%s.json", headSlot))
+	httputil.WriteJson(w, &structs.ExportPoolResponse{
+		Version:  version.String(headState.Version()),
+		HeadSlot: headSlot,
+		HeadRoot: headRoot,
+		Data: &structs.PoolExport{
+			Attestations:          atts,
+			VoluntaryExits:        exitStructs,
+			BLSToExecutionChanges: structs.SignedBLSChangesFromConsensus(changes),
+			AttesterSlashings:     attesterSlashings,
+			ProposerSlashings:     structs.ProposerSlashingsFromConsensus(proposerSlashings),
+		},
+	})
+}
+
+// PruneSlashings removes pooled attester and proposer slashings whose validators are all already slashed
+// in the head state, as such evidence can no longer be included in a block. It returns the number of
+// slashings that were removed.
+func (s *Server) PruneSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.PruneSlashings")
+	defer span.End()
+	defer recoverPoolHandler(w, span)
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	attester, proposer := s.SlashingsPool.PruneSlashedValidators(headState)
+	httputil.WriteJson(w, &structs.PruneSlashingsResponse{
+		Data: &structs.PrunedSlashings{
+			AttesterSlashings: strconv.Itoa(attester),
+			ProposerSlashings: strconv.Itoa(proposer),
+		},
+	})
+}
+
+// headStatePosition returns the slot and the block root of the head state that a response was computed from,
+// so that clients can correlate responses with a chain position. The block root is derived from the state itself
+// rather than read separately from fork choice, which could have moved to a different head in the meantime.
+func headStatePosition(ctx context.Context, headState state.ReadOnlyBeaconState) (string, string, error) {
+	header := headState.LatestBlockHeader()
+	// The state root of the latest block header is only filled in when the next slot is processed.
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		hasher, ok := headState.(interface {
+			HashTreeRoot(ctx context.Context) ([32]byte, error)
+		})
+		if !ok {
+			return "", "", fmt.Errorf("could not compute state root of state type %T", headState)
+		}
+		stateRoot, err := hasher.HashTreeRoot(ctx)
+		if err != nil {
+			return "", "", errors.Wrap(err, "could not compute state root")
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	blockRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return "", "", errors.Wrap(err, "could not compute block root")
+	}
+	return strconv.FormatUint(uint64(headState.Slot()), 10), hexutil.Encode(blockRoot[:]), nil
+}
 
-	httputil.WriteJson(w, &structs.GetProposerSlashingsResponse{Data: slashings})
+// proposerSlashingsSSZ serializes the slashings as an SSZ list. ProposerSlashing is a fixed-size type,
+// so the list is the concatenation of the serialized slashings.
+func proposerSlashingsSSZ(slashings []*eth.ProposerSlashing) ([]byte, error) {
+	sszData := make([]byte, 0, len(slashings)*(&eth.ProposerSlashing{}).SizeSSZ())
+	for _, slashing := range slashings {
+		b, err := slashing.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		sszData = append(sszData, b...)
+	}
+	return sszData, nil
 }
 
 // SubmitProposerSlashing submits a proposer slashing object to node's pool and if
-// passes validation node MUST broadcast it to network.
+// passes validation node MUST broadcast it to network. When the head state is ahead of the slashing,
+// the slot of the state used for verification is reported in the X-Verification-State-Slot header.
+// When a header signature does not verify because the header was signed for a fork other than the fork of its slot,
+// the error names the fork versions that the headers were signed under.
 func (s *Server) SubmitProposerSlashing(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitProposerSlashing")
 	defer span.End()
+	defer recoverPoolHandler(w, span)
 
 	var req structs.ProposerSlashing
-	err := json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case errors.Is(err, io.EOF):
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+	if !shared.DecodeJSONBody(w, r, &req) {
 		return
 	}
 
@@ -928,18 +4557,43 @@ func (s *Server) SubmitProposerSlashing(w http.ResponseWriter, r *http.Request)
 		httputil.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
 		return
 	}
+	headerSlot := slashing.Header_1.Header.Slot
+	if headerSlot != slashing.Header_2.Header.Slot {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Invalid proposer slashing: header slots %d and %d are not equal", headerSlot, slashing.Header_2.Header.Slot),
+			http.StatusBadRequest,
+		)
+		return
+	}
 	headState, err := s.ChainInfoFetcher.HeadState(ctx)
 	if err != nil {
 		httputil.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, slashing.Header_1.Header.Slot)
+	// Slots past the current slot can never be reached, so there is no point in processing slots up to them.
+	if currentSlot := slots.CurrentSlot(headState.GenesisTime()); headerSlot > currentSlot {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Invalid proposer slashing: header slot %d is in the future, current slot is %d", headerSlot, currentSlot),
+			http.StatusBadRequest,
+		)
+		return
+	}
+	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, headerSlot)
 	if err != nil {
 		httputil.HandleError(w, "Could not process slots: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	setVerificationStateSlotHeader(w, headState, headerSlot)
 	err = blocks.VerifyProposerSlashing(headState, slashing)
 	if err != nil {
+		// A more specific error is returned when a signature failure is caused by headers signed for another fork.
+		if errors.Is(err, signing.ErrSigFailedToVerify) {
+			if forkErr := proposerSlashingForkVersionMismatch(headState, slashing); forkErr != nil {
+				err = forkErr
+			}
+		}
 		httputil.HandleError(w, "Invalid proposer slashing: "+err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -949,6 +4603,14 @@ func (s *Server) SubmitProposerSlashing(w http.ResponseWriter, r *http.Request)
 		httputil.HandleError(w, "Could not insert proposer slashing into pool: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if root, err := slashing.HashTreeRoot(); err != nil {
+		log.WithError(err).Error("could not compute proposer slashing root")
+	} else {
+		s.SubmittedSlashings.Record(root, SubmittedSlashing{
+			Kind:       SlashingKindProposer,
+			Validators: []primitives.ValidatorIndex{slashing.Header_1.Header.ProposerIndex},
+		})
+	}
 
 	// notify events
 	s.OperationNotifier.OperationFeed().Send(&feed.Event{
@@ -965,3 +4627,70 @@ func (s *Server) SubmitProposerSlashing(w http.ResponseWriter, r *http.Request)
 		}
 	}
 }
+
+// proposerSlashingForkVersionMismatch explains a failed proposer slashing verification that is caused by headers signed
+// under the proposer domain of a fork other than the fork of their slot, which is an easy mistake to make near fork transitions.
+// Every header is verified under the fork version of its slot first and under the versions of the fork schedule otherwise.
+// A nil error is returned when no header is found to be signed for another fork.
+func proposerSlashingForkVersionMismatch(st state.ReadOnlyBeaconState, slashing *eth.ProposerSlashing) error {
+	cfg := params.BeaconConfig()
+	slot := slashing.Header_1.Header.Slot
+	fork := st.Fork()
+	expected := fork.CurrentVersion
+	if slots.ToEpoch(slot) < fork.Epoch {
+		expected = fork.PreviousVersion
+	}
+	candidates := [][]byte{expected}
+	scheduled := make([][fieldparams.VersionLength]byte, 0, len(cfg.ForkVersionSchedule))
+	for v := range cfg.ForkVersionSchedule {
+		scheduled = append(scheduled, v)
+	}
+	sort.Slice(scheduled, func(i, j int) bool {
+		return cfg.ForkVersionSchedule[scheduled[i]] < cfg.ForkVersionSchedule[scheduled[j]]
+	})
+	for _, v := range scheduled {
+		if !bytes.Equal(v[:], expected) {
+			candidates = append(candidates, bytesutil.SafeCopyBytes(v[:]))
+		}
+	}
+
+	pubkey := st.PubkeyAtIndex(slashing.Header_1.Header.ProposerIndex)
+	headers := []*eth.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2}
+	signedWith := make([][]byte, len(headers))
+	for i, header := range headers {
+		for _, v := range candidates {
+			domain, err := signing.ComputeDomain(cfg.DomainBeaconProposer, v, st.GenesisValidatorsRoot())
+			if err != nil {
+				return nil
+			}
+			if signing.VerifyBlockHeaderSigningRoot(header.Header, pubkey[:], header.Signature, domain) == nil {
+				signedWith[i] = v
+				break
+			}
+		}
+	}
+	if signedWith[0] == nil || signedWith[1] == nil {
+		return nil
+	}
+	if !bytes.Equal(signedWith[0], signedWith[1]) {
+		return fmt.Errorf("headers are signed under different fork versions %#x and %#x", signedWith[0], signedWith[1])
+	}
+	if !bytes.Equal(signedWith[0], expected) {
+		return fmt.Errorf("headers are signed under fork version %#x, but slot %d belongs to fork version %#x", signedWith[0], slot, expected)
+	}
+	return nil
+}
+
+// recoverPoolHandler converts a panic raised while serving a pool request into an internal server error,
+// so that a single malformed pool entry does not tear down the client's connection.
+// The panic details are only logged, the client receives a fixed message.
+// It has to be deferred directly by the handler, after the handler's span is started.
+func recoverPoolHandler(w http.ResponseWriter, span oteltrace.Span) {
+	if rec := recover(); rec != nil {
+		tracing.AnnotateError(span, fmt.Errorf("panic occurred: %v", rec))
+		log.WithField("error", rec).
+			WithField("stack", string(debug.Stack())).
+			Error("Panic occurred while handling pool request")
+		httputil.HandleError(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
@@ -3,32 +3,46 @@ package beacon
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	prysmtime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec"
 	blstoexecmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec/mock"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
 	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits/mock"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	p2pMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
@@ -43,9 +57,26 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestListAttestations(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	// Results in 8 committees per slot for the validators below.
+	c.TargetCommitteeSize = 1
+	params.OverrideBeaconConfig(c)
+	// The active validator count is cached by seed, which is shared with the states of other tests.
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+	validators := make([]*ethpbv1alpha1.Validator, 256)
+	for i := range validators {
+		validators[i] = &ethpbv1alpha1.Validator{
+			PublicKey: bytesutil.PadTo(bytesutil.Uint64ToBytesBigEndian(uint64(i)), 48),
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+
 	att1 := &ethpbv1alpha1.Attestation{
 		AggregationBits: []byte{1, 10},
 		Data: &ethpbv1alpha1.AttestationData{
@@ -115,7 +146,13 @@ func TestListAttestations(t *testing.T) {
 		Signature: bytesutil.PadTo([]byte("signature4"), 96),
 	}
 	t.Run("V1", func(t *testing.T) {
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = validators
+			return nil
+		})
+		require.NoError(t, err)
 		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
 			AttestationsPool: attestations.NewPool(),
 		}
 		require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att1, att2}))
@@ -129,6 +166,7 @@ func TestListAttestations(t *testing.T) {
 
 			s.ListAttestations(writer, request)
 			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, "4", writer.Header().Get(api.PoolSizeHeader))
 			resp := &structs.ListAttestationsResponse{}
 			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
 			require.NotNil(t, resp)
@@ -178,6 +216,18 @@ func TestListAttestations(t *testing.T) {
 				assert.Equal(t, "4", a.Data.CommitteeIndex)
 			}
 		})
+		t.Run("index out of range", func(t *testing.T) {
+			url := "http://example.com?slot=2&committee_index=1,8"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "committee_index 8 is out of range: the committee count per slot is 8", e.Message)
+		})
 		t.Run("both slot + index request", func(t *testing.T) {
 			url := "http://example.com?slot=2&committee_index=4"
 			request := httptest.NewRequest(http.MethodGet, url, nil)
@@ -199,10 +249,197 @@ func TestListAttestations(t *testing.T) {
 				assert.Equal(t, "4", a.Data.CommitteeIndex)
 			}
 		})
+		t.Run("multiple indices request", func(t *testing.T) {
+			url := "http://example.com?committee_index=1,2&committee_index=4"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.ListAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+			require.NotNil(t, resp)
+			require.NotNil(t, resp.Data)
+
+			var atts []*structs.Attestation
+			require.NoError(t, json.Unmarshal(resp.Data, &atts))
+			assert.Equal(t, 4, len(atts))
+
+			url = "http://example.com?committee_index=1,%202"
+			request = httptest.NewRequest(http.MethodGet, url, nil)
+			writer = httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp = &structs.ListAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+			require.NoError(t, json.Unmarshal(resp.Data, &atts))
+			assert.Equal(t, 2, len(atts))
+			for _, a := range atts {
+				assert.NotEqual(t, "4", a.Data.CommitteeIndex)
+			}
+		})
+		t.Run("invalid index request", func(t *testing.T) {
+			url := "http://example.com?committee_index=1,foo"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "committee_index is invalid", e.Message)
+		})
+		t.Run("include ssz", func(t *testing.T) {
+			url := "http://example.com?slot=1&committee_index=1&include_ssz=true"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.ListAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+			require.NotNil(t, resp)
+			require.NotNil(t, resp.Data)
+
+			var atts []*structs.AttestationWithSSZ
+			require.NoError(t, json.Unmarshal(resp.Data, &atts))
+			require.Equal(t, 1, len(atts))
+			assert.Equal(t, "1", atts[0].Data.Slot)
+			sszBytes, err := base64.StdEncoding.DecodeString(atts[0].SSZ)
+			require.NoError(t, err)
+			expected, err := att1.MarshalSSZ()
+			require.NoError(t, err)
+			assert.DeepEqual(t, expected, sszBytes)
+		})
+		t.Run("response size cap", func(t *testing.T) {
+			attData, err := json.Marshal(structs.AttFromConsensus(att1))
+			require.NoError(t, err)
+			// All pooled attestations are of the same size, so only two of them fit.
+			s.MaxListResponseSize = uint64(3*len(attData) + 3)
+			defer func() {
+				s.MaxListResponseSize = 0
+			}()
+			request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, "true", writer.Header().Get(api.ResponseTruncatedHeader))
+			assert.Equal(t, "4", writer.Header().Get(api.TotalCountHeader))
+			resp := &structs.ListAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+			var atts []*structs.Attestation
+			require.NoError(t, json.Unmarshal(resp.Data, &atts))
+			assert.Equal(t, 2, len(atts))
+		})
+		t.Run("invalid include ssz", func(t *testing.T) {
+			url := "http://example.com?include_ssz=foo"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+		})
+		t.Run("since slot", func(t *testing.T) {
+			url := "http://example.com?since_slot=1"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.ListAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+			require.NotNil(t, resp)
+			require.NotNil(t, resp.Data)
+
+			var atts []*structs.Attestation
+			require.NoError(t, json.Unmarshal(resp.Data, &atts))
+			assert.Equal(t, 2, len(atts))
+			for _, a := range atts {
+				assert.Equal(t, "2", a.Data.Slot)
+			}
+		})
+		t.Run("invalid since slot", func(t *testing.T) {
+			url := "http://example.com?since_slot=foo"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+		})
+		t.Run("singleton only", func(t *testing.T) {
+			singleton := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+				AggregationBits: bitfield.Bitlist{0b0101},
+				Data:            &ethpbv1alpha1.AttestationData{Slot: 3},
+			})
+			multiBit := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+				AggregationBits: bitfield.Bitlist{0b0111},
+				Data:            &ethpbv1alpha1.AttestationData{Slot: 4},
+			})
+			s := &Server{
+				AttestationsPool: attestations.NewPool(),
+			}
+			require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att1, multiBit}))
+			require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{singleton}))
+
+			url := "http://example.com?singleton_only=true"
+			request := httptest.NewRequest(http.MethodGet, url, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.ListAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+			require.NotNil(t, resp)
+			require.NotNil(t, resp.Data)
+
+			var atts []*structs.Attestation
+			require.NoError(t, json.Unmarshal(resp.Data, &atts))
+			require.Equal(t, 1, len(atts))
+			assert.Equal(t, "3", atts[0].Data.Slot)
+		})
+		t.Run("skips Electra attestations", func(t *testing.T) {
+			s := &Server{
+				AttestationsPool: attestations.NewPool(),
+			}
+			attElectra := util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{
+				AggregationBits: bitfield.Bitlist{0b0111},
+				Data:            &ethpbv1alpha1.AttestationData{Slot: 5},
+			})
+			require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att1, attElectra}))
+
+			request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.ListAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+			require.NotNil(t, resp.Data)
+
+			var atts []*structs.Attestation
+			require.NoError(t, json.Unmarshal(resp.Data, &atts))
+			require.Equal(t, 1, len(atts))
+			assert.Equal(t, fmt.Sprintf("%d", att1.Data.Slot), atts[0].Data.Slot)
+		})
 	})
 	t.Run("V2", func(t *testing.T) {
 		t.Run("Pre-Electra", func(t *testing.T) {
-			bs, err := util.NewBeaconState()
+			bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+				state.Validators = validators
+				return nil
+			})
 			require.NoError(t, err)
 			s := &Server{
 				ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
@@ -270,6 +507,18 @@ func TestListAttestations(t *testing.T) {
 					assert.Equal(t, "4", a.Data.CommitteeIndex)
 				}
 			})
+			t.Run("index out of range", func(t *testing.T) {
+				url := "http://example.com?slot=2&committee_index=1,8"
+				request := httptest.NewRequest(http.MethodGet, url, nil)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.ListAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.StringContains(t, "committee_index 8 is out of range: the committee count per slot is 8", e.Message)
+			})
 			t.Run("both slot + index request", func(t *testing.T) {
 				url := "http://example.com?slot=2&committee_index=4"
 				request := httptest.NewRequest(http.MethodGet, url, nil)
@@ -292,15 +541,58 @@ func TestListAttestations(t *testing.T) {
 					assert.Equal(t, "4", a.Data.CommitteeIndex)
 				}
 			})
+			t.Run("include source", func(t *testing.T) {
+				resetCfg := features.InitWithReset(&features.Flags{EnableAttestationSourceTagging: true})
+				defer resetCfg()
+				require.NoError(t, s.AttestationsPool.SaveRPCAttestationSource(att1))
+
+				url := "http://example.com?include_source=true"
+				request := httptest.NewRequest(http.MethodGet, url, nil)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.ListAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusOK, writer.Code)
+				resp := &structs.ListAttestationsResponse{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+				require.NotNil(t, resp.Data)
+
+				var atts []*structs.AttestationWithSSZ
+				require.NoError(t, json.Unmarshal(resp.Data, &atts))
+				require.Equal(t, 4, len(atts))
+				sources := make(map[string]int)
+				for _, a := range atts {
+					sources[a.Source]++
+					assert.Equal(t, "", a.SSZ)
+				}
+				assert.DeepEqual(t, map[string]int{"rpc": 1, "gossip": 3}, sources)
+			})
+			t.Run("include source without source tagging", func(t *testing.T) {
+				url := "http://example.com?include_source=true"
+				request := httptest.NewRequest(http.MethodGet, url, nil)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.ListAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusNotImplemented, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.StringContains(t, "attestation source tagging feature is disabled", e.Message)
+			})
 		})
 		t.Run("Post-Electra", func(t *testing.T) {
-			cb := primitives.NewAttestationCommitteeBits()
-			cb.SetBitAt(1, true)
+			// Electra attestations identify their committee through committee bits, with the data's committee index set to 0.
+			cb1 := primitives.NewAttestationCommitteeBits()
+			cb1.SetBitAt(1, true)
+			cb2 := primitives.NewAttestationCommitteeBits()
+			cb2.SetBitAt(2, true)
+			cb4 := primitives.NewAttestationCommitteeBits()
+			cb4.SetBitAt(4, true)
 			attElectra1 := &ethpbv1alpha1.AttestationElectra{
 				AggregationBits: []byte{1, 10},
 				Data: &ethpbv1alpha1.AttestationData{
 					Slot:            1,
-					CommitteeIndex:  1,
+					CommitteeIndex:  0,
 					BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot1"), 32),
 					Source: &ethpbv1alpha1.Checkpoint{
 						Epoch: 1,
@@ -311,14 +603,14 @@ func TestListAttestations(t *testing.T) {
 						Root:  bytesutil.PadTo([]byte("targetroot1"), 32),
 					},
 				},
-				CommitteeBits: cb,
+				CommitteeBits: cb1,
 				Signature:     bytesutil.PadTo([]byte("signature1"), 96),
 			}
 			attElectra2 := &ethpbv1alpha1.AttestationElectra{
 				AggregationBits: []byte{1, 10},
 				Data: &ethpbv1alpha1.AttestationData{
 					Slot:            1,
-					CommitteeIndex:  4,
+					CommitteeIndex:  0,
 					BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot2"), 32),
 					Source: &ethpbv1alpha1.Checkpoint{
 						Epoch: 1,
@@ -329,14 +621,14 @@ func TestListAttestations(t *testing.T) {
 						Root:  bytesutil.PadTo([]byte("targetroot2"), 32),
 					},
 				},
-				CommitteeBits: cb,
+				CommitteeBits: cb4,
 				Signature:     bytesutil.PadTo([]byte("signature2"), 96),
 			}
 			attElectra3 := &ethpbv1alpha1.AttestationElectra{
 				AggregationBits: bitfield.NewBitlist(8),
 				Data: &ethpbv1alpha1.AttestationData{
 					Slot:            2,
-					CommitteeIndex:  2,
+					CommitteeIndex:  0,
 					BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot3"), 32),
 					Source: &ethpbv1alpha1.Checkpoint{
 						Epoch: 1,
@@ -347,14 +639,14 @@ func TestListAttestations(t *testing.T) {
 						Root:  bytesutil.PadTo([]byte("targetroot3"), 32),
 					},
 				},
-				CommitteeBits: cb,
+				CommitteeBits: cb2,
 				Signature:     bytesutil.PadTo([]byte("signature3"), 96),
 			}
 			attElectra4 := &ethpbv1alpha1.AttestationElectra{
 				AggregationBits: bitfield.NewBitlist(8),
 				Data: &ethpbv1alpha1.AttestationData{
 					Slot:            2,
-					CommitteeIndex:  4,
+					CommitteeIndex:  0,
 					BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot4"), 32),
 					Source: &ethpbv1alpha1.Checkpoint{
 						Epoch: 1,
@@ -365,10 +657,13 @@ func TestListAttestations(t *testing.T) {
 						Root:  bytesutil.PadTo([]byte("targetroot4"), 32),
 					},
 				},
-				CommitteeBits: cb,
+				CommitteeBits: cb4,
 				Signature:     bytesutil.PadTo([]byte("signature4"), 96),
 			}
-			bs, err := util.NewBeaconStateElectra()
+			bs, err := util.NewBeaconStateElectra(func(state *ethpbv1alpha1.BeaconStateElectra) error {
+				state.Validators = validators
+				return nil
+			})
 			require.NoError(t, err)
 			s := &Server{
 				AttestationsPool: attestations.NewPool(),
@@ -434,9 +729,41 @@ func TestListAttestations(t *testing.T) {
 				assert.Equal(t, 2, len(atts))
 				assert.Equal(t, "electra", resp.Version)
 				for _, a := range atts {
-					assert.Equal(t, "4", a.Data.CommitteeIndex)
+					assert.Equal(t, hexutil.Encode(cb4), a.CommitteeBits)
+				}
+			})
+			t.Run("multiple indices request", func(t *testing.T) {
+				url := "http://example.com?committee_index=1&committee_index=2"
+				request := httptest.NewRequest(http.MethodGet, url, nil)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.ListAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusOK, writer.Code)
+				resp := &structs.ListAttestationsResponse{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+				require.NotNil(t, resp)
+				require.NotNil(t, resp.Data)
+
+				var atts []*structs.AttestationElectra
+				require.NoError(t, json.Unmarshal(resp.Data, &atts))
+				assert.Equal(t, 2, len(atts))
+				for _, a := range atts {
+					assert.NotEqual(t, hexutil.Encode(cb4), a.CommitteeBits)
 				}
 			})
+			t.Run("index out of range", func(t *testing.T) {
+				url := "http://example.com?slot=2&committee_index=1,8"
+				request := httptest.NewRequest(http.MethodGet, url, nil)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.ListAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.StringContains(t, "committee_index 8 is out of range: the committee count per slot is 8", e.Message)
+			})
 			t.Run("both slot + index request", func(t *testing.T) {
 				url := "http://example.com?slot=2&committee_index=4"
 				request := httptest.NewRequest(http.MethodGet, url, nil)
@@ -456,386 +783,3621 @@ func TestListAttestations(t *testing.T) {
 				assert.Equal(t, "electra", resp.Version)
 				for _, a := range atts {
 					assert.Equal(t, "2", a.Data.Slot)
-					assert.Equal(t, "4", a.Data.CommitteeIndex)
+					assert.Equal(t, hexutil.Encode(cb4), a.CommitteeBits)
 				}
 			})
 		})
 	})
 }
 
-func TestSubmitAttestations(t *testing.T) {
-	transition.SkipSlotCache.Disable()
-	defer transition.SkipSlotCache.Enable()
-
-	params.SetupTestConfigCleanup(t)
-	c := params.BeaconConfig().Copy()
-	// Required for correct committee size calculation.
-	c.SlotsPerEpoch = 1
-	params.OverrideBeaconConfig(c)
-
-	_, keys, err := util.DeterministicDepositsAndKeys(1)
-	require.NoError(t, err)
-	validators := []*ethpbv1alpha1.Validator{
-		{
-			PublicKey: keys[0].PublicKey().Marshal(),
-			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
-		},
+// poolAttestation returns a phase0 attestation for the slot with the given bits set in an aggregation bitlist of length 4.
+func poolAttestation(slot primitives.Slot, bits ...uint64) *ethpbv1alpha1.Attestation {
+	aggBits := bitfield.NewBitlist(4)
+	for _, b := range bits {
+		aggBits.SetBitAt(b, true)
 	}
-	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
-		state.Validators = validators
-		state.Slot = 1
-		state.PreviousJustifiedCheckpoint = &ethpbv1alpha1.Checkpoint{
-			Epoch: 0,
-			Root:  bytesutil.PadTo([]byte("sourceroot1"), 32),
-		}
-		return nil
-	})
-	require.NoError(t, err)
-	b := bitfield.NewBitlist(1)
-	b.SetBitAt(0, true)
+	return util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: aggBits, Data: &ethpbv1alpha1.AttestationData{Slot: slot}})
+}
 
-	chainService := &blockchainmock.ChainService{State: bs}
+func TestListAttestationsV2_NonRedundant(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
 	s := &Server{
-		HeadFetcher:       chainService,
-		ChainInfoFetcher:  chainService,
-		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
 	}
-	t.Run("V1", func(t *testing.T) {
-		t.Run("single", func(t *testing.T) {
-			broadcaster := &p2pMock.MockBroadcaster{}
-			s.Broadcaster = broadcaster
-			s.AttestationsPool = attestations.NewPool()
-
-			var body bytes.Buffer
-			_, err := body.WriteString(singleAtt)
-			require.NoError(t, err)
-			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-			writer := httptest.NewRecorder()
-			writer.Body = &bytes.Buffer{}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(1, 0, 1), poolAttestation(1, 1, 2, 3), poolAttestation(2, 0, 1)}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(1, 3), poolAttestation(1, 0), poolAttestation(2, 2)}))
 
-			s.SubmitAttestations(writer, request)
-
-			assert.Equal(t, http.StatusOK, writer.Code)
-			assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
-			assert.Equal(t, 1, broadcaster.NumAttestations())
-			assert.Equal(t, "0x03", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetAggregationBits()))
-			assert.Equal(t, "0x8146f4397bfd8fd057ebbcd6a67327bdc7ed5fb650533edcb6377b650dea0b6da64c14ecd60846d5c0a0cd43893d6972092500f82c9d8a955e2b58c5ed3cbe885d84008ace6bd86ba9e23652f58e2ec207cec494c916063257abf285b9b15b15", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetSignature()))
-			assert.Equal(t, primitives.Slot(0), broadcaster.BroadcastAttestations[0].GetData().Slot)
-			assert.Equal(t, primitives.CommitteeIndex(0), broadcaster.BroadcastAttestations[0].GetData().CommitteeIndex)
-			assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().BeaconBlockRoot))
-			assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Source.Root))
-			assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Source.Epoch)
-			assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Target.Root))
-			assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Target.Epoch)
-			assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
-		})
-		t.Run("multiple", func(t *testing.T) {
-			broadcaster := &p2pMock.MockBroadcaster{}
-			s.Broadcaster = broadcaster
-			s.AttestationsPool = attestations.NewPool()
+	request := httptest.NewRequest(http.MethodGet, "http://example.com?non_redundant=true", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
 
-			var body bytes.Buffer
-			_, err := body.WriteString(multipleAtts)
-			require.NoError(t, err)
-			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-			writer := httptest.NewRecorder()
-			writer.Body = &bytes.Buffer{}
+	s.ListAttestationsV2(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.ListAttestationsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	var atts []*structs.Attestation
+	require.NoError(t, json.Unmarshal(resp.Data, &atts))
+	require.Equal(t, 4, len(atts))
+	bitsBySlot := make(map[string][]string)
+	for _, a := range atts {
+		bitsBySlot[a.Data.Slot] = append(bitsBySlot[a.Data.Slot], a.AggregationBits)
+	}
+	// Selected attestations keep the listing order.
+	assert.DeepEqual(t, []string{hexutil.Encode(poolAttestation(1, 0, 1).AggregationBits), hexutil.Encode(poolAttestation(1, 1, 2, 3).AggregationBits)}, bitsBySlot["1"])
+	assert.DeepEqual(t, []string{hexutil.Encode(poolAttestation(2, 0, 1).AggregationBits), hexutil.Encode(poolAttestation(2, 2).AggregationBits)}, bitsBySlot["2"])
+}
 
-			s.SubmitAttestations(writer, request)
-			assert.Equal(t, http.StatusOK, writer.Code)
-			assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
-			assert.Equal(t, 2, broadcaster.NumAttestations())
-			assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
-		})
-		t.Run("no body", func(t *testing.T) {
-			request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
-			writer := httptest.NewRecorder()
-			writer.Body = &bytes.Buffer{}
+func TestListAttestationsV2_ParticipantsRange(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+	}
+	att := func(slot primitives.Slot, participants uint64) *ethpbv1alpha1.Attestation {
+		aggBits := bitfield.NewBitlist(8)
+		for i := uint64(0); i < participants; i++ {
+			aggBits.SetBitAt(i, true)
+		}
+		return util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: aggBits, Data: &ethpbv1alpha1.AttestationData{Slot: slot}})
+	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att(2, 2), att(3, 3), att(5, 5), att(7, 7)}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{att(1, 1)}))
 
-			s.SubmitAttestations(writer, request)
-			assert.Equal(t, http.StatusBadRequest, writer.Code)
-			e := &httputil.DefaultJsonError{}
-			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-			assert.Equal(t, http.StatusBadRequest, e.Code)
-			assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
-		})
-		t.Run("empty", func(t *testing.T) {
-			var body bytes.Buffer
-			_, err := body.WriteString("[]")
-			require.NoError(t, err)
-			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-			writer := httptest.NewRecorder()
-			writer.Body = &bytes.Buffer{}
+	listSlots := func(t *testing.T, query string) []string {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
 
-			s.SubmitAttestations(writer, request)
-			assert.Equal(t, http.StatusBadRequest, writer.Code)
-			e := &httputil.DefaultJsonError{}
-			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-			assert.Equal(t, http.StatusBadRequest, e.Code)
-			assert.Equal(t, true, strings.Contains(e.Message, "no data submitted"))
-		})
-		t.Run("invalid", func(t *testing.T) {
-			var body bytes.Buffer
-			_, err := body.WriteString(invalidAtt)
-			require.NoError(t, err)
-			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-			writer := httptest.NewRecorder()
-			writer.Body = &bytes.Buffer{}
+		s.ListAttestationsV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		slots := make([]string, len(atts))
+		for i, a := range atts {
+			slots[i] = a.Data.Slot
+		}
+		slices.Sort(slots)
+		return slots
+	}
 
-			s.SubmitAttestations(writer, request)
-			assert.Equal(t, http.StatusBadRequest, writer.Code)
-			e := &server.IndexedVerificationFailureError{}
-			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-			assert.Equal(t, http.StatusBadRequest, e.Code)
-			require.Equal(t, 1, len(e.Failures))
-			assert.Equal(t, true, strings.Contains(e.Failures[0].Message, "Incorrect attestation signature"))
-		})
+	t.Run("band", func(t *testing.T) {
+		assert.DeepEqual(t, []string{"2", "3", "5"}, listSlots(t, "min_participants=2&max_participants=5"))
 	})
-	t.Run("V2", func(t *testing.T) {
-		t.Run("pre-electra", func(t *testing.T) {
-			t.Run("single", func(t *testing.T) {
-				broadcaster := &p2pMock.MockBroadcaster{}
-				s.Broadcaster = broadcaster
-				s.AttestationsPool = attestations.NewPool()
+	t.Run("min only", func(t *testing.T) {
+		assert.DeepEqual(t, []string{"5", "7"}, listSlots(t, "min_participants=4"))
+	})
+	t.Run("max only", func(t *testing.T) {
+		assert.DeepEqual(t, []string{"1", "2"}, listSlots(t, "max_participants=2"))
+	})
+	t.Run("equal bounds", func(t *testing.T) {
+		assert.DeepEqual(t, []string{"3"}, listSlots(t, "min_participants=3&max_participants=3"))
+	})
+	t.Run("min greater than max", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?min_participants=5&max_participants=2", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
 
-				var body bytes.Buffer
-				_, err := body.WriteString(singleAtt)
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
-				writer := httptest.NewRecorder()
-				writer.Body = &bytes.Buffer{}
+		s.ListAttestationsV2(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "min_participants 5 is greater than max_participants 2", e.Message)
+	})
+}
 
-				s.SubmitAttestationsV2(writer, request)
+func TestListAttestations_Epoch(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	att := func(slot primitives.Slot) *ethpbv1alpha1.Attestation {
+		return util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+			AggregationBits: bitfield.Bitlist{0b11},
+			Data:            &ethpbv1alpha1.AttestationData{Slot: slot},
+		})
+	}
+	attElectra := func(slot primitives.Slot) *ethpbv1alpha1.AttestationElectra {
+		committeeBits := primitives.NewAttestationCommitteeBits()
+		committeeBits.SetBitAt(0, true)
+		return util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{
+			AggregationBits: bitfield.Bitlist{0b11},
+			Data:            &ethpbv1alpha1.AttestationData{Slot: slot},
+			CommitteeBits:   committeeBits,
+		})
+	}
+	epochSlots := []primitives.Slot{slotsPerEpoch - 1, slotsPerEpoch, 2*slotsPerEpoch - 1, 2 * slotsPerEpoch}
+	list := func(t *testing.T, handler http.HandlerFunc, query string) []string {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
 
-				assert.Equal(t, http.StatusOK, writer.Code)
-				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
-				assert.Equal(t, 1, broadcaster.NumAttestations())
-				assert.Equal(t, "0x03", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetAggregationBits()))
-				assert.Equal(t, "0x8146f4397bfd8fd057ebbcd6a67327bdc7ed5fb650533edcb6377b650dea0b6da64c14ecd60846d5c0a0cd43893d6972092500f82c9d8a955e2b58c5ed3cbe885d84008ace6bd86ba9e23652f58e2ec207cec494c916063257abf285b9b15b15", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetSignature()))
-				assert.Equal(t, primitives.Slot(0), broadcaster.BroadcastAttestations[0].GetData().Slot)
-				assert.Equal(t, primitives.CommitteeIndex(0), broadcaster.BroadcastAttestations[0].GetData().CommitteeIndex)
-				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().BeaconBlockRoot))
-				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Source.Root))
-				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Source.Epoch)
-				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Target.Root))
-				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Target.Epoch)
-				assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
-			})
-			t.Run("multiple", func(t *testing.T) {
-				broadcaster := &p2pMock.MockBroadcaster{}
-				s.Broadcaster = broadcaster
-				s.AttestationsPool = attestations.NewPool()
+		handler(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		slots := make([]string, len(atts))
+		for i, a := range atts {
+			slots[i] = a.Data.Slot
+		}
+		slices.Sort(slots)
+		return slots
+	}
+	want := []string{fmt.Sprintf("%d", slotsPerEpoch), fmt.Sprintf("%d", 2*slotsPerEpoch-1)}
+	slices.Sort(want)
 
-				var body bytes.Buffer
-				_, err := body.WriteString(multipleAtts)
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
-				writer := httptest.NewRecorder()
-				writer.Body = &bytes.Buffer{}
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+	}
+	for _, slot := range epochSlots {
+		require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{att(slot)}))
+	}
 
-				s.SubmitAttestationsV2(writer, request)
-				assert.Equal(t, http.StatusOK, writer.Code)
-				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
-				assert.Equal(t, 2, broadcaster.NumAttestations())
-				assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
-			})
-			t.Run("no body", func(t *testing.T) {
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
-				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+	t.Run("V1", func(t *testing.T) {
+		assert.DeepEqual(t, want, list(t, s.ListAttestations, "epoch=1"))
+	})
+	t.Run("V2 pre-Electra", func(t *testing.T) {
+		assert.DeepEqual(t, want, list(t, s.ListAttestationsV2, "epoch=1"))
+	})
+	t.Run("V2 Electra", func(t *testing.T) {
+		bsElectra, err := util.NewBeaconStateElectra()
+		require.NoError(t, err)
+		sElectra := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bsElectra},
+			AttestationsPool: attestations.NewPool(),
+		}
+		for _, slot := range epochSlots {
+			require.NoError(t, sElectra.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{attElectra(slot)}))
+		}
+		assert.DeepEqual(t, want, list(t, sElectra.ListAttestationsV2, "epoch=1"))
+	})
+	t.Run("slot and epoch", func(t *testing.T) {
+		for name, handler := range map[string]http.HandlerFunc{"V1": s.ListAttestations, "V2": s.ListAttestationsV2} {
+			t.Run(name, func(t *testing.T) {
+				request := httptest.NewRequest(http.MethodGet, "http://example.com?slot=1&epoch=1", nil)
 				writer := httptest.NewRecorder()
 				writer.Body = &bytes.Buffer{}
 
-				s.SubmitAttestationsV2(writer, request)
+				handler(writer, request)
 				assert.Equal(t, http.StatusBadRequest, writer.Code)
 				e := &httputil.DefaultJsonError{}
 				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-				assert.Equal(t, http.StatusBadRequest, e.Code)
-				assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+				assert.StringContains(t, "slot and epoch query parameters are mutually exclusive", e.Message)
 			})
-			t.Run("empty", func(t *testing.T) {
-				var body bytes.Buffer
-				_, err := body.WriteString("[]")
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+		}
+	})
+}
+
+func TestListAttestations_FFGEpochs(t *testing.T) {
+	att := func(slot primitives.Slot, sourceEpoch, targetEpoch primitives.Epoch) *ethpbv1alpha1.Attestation {
+		return util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+			AggregationBits: bitfield.Bitlist{0b11},
+			Data: &ethpbv1alpha1.AttestationData{
+				Slot:   slot,
+				Source: &ethpbv1alpha1.Checkpoint{Epoch: sourceEpoch},
+				Target: &ethpbv1alpha1.Checkpoint{Epoch: targetEpoch},
+			},
+		})
+	}
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+	}
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{
+		att(1, 0, 1),
+		att(2, 1, 1),
+		att(3, 1, 2),
+		att(4, 1, 2),
+	}))
+
+	for name, handler := range map[string]http.HandlerFunc{"V1": s.ListAttestations, "V2": s.ListAttestationsV2} {
+		t.Run(name, func(t *testing.T) {
+			list := func(t *testing.T, query string) []string {
+				request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
 				writer := httptest.NewRecorder()
 				writer.Body = &bytes.Buffer{}
 
-				s.SubmitAttestationsV2(writer, request)
-				assert.Equal(t, http.StatusBadRequest, writer.Code)
-				e := &httputil.DefaultJsonError{}
-				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-				assert.Equal(t, http.StatusBadRequest, e.Code)
-				assert.Equal(t, true, strings.Contains(e.Message, "no data submitted"))
-			})
-			t.Run("invalid", func(t *testing.T) {
-				var body bytes.Buffer
-				_, err := body.WriteString(invalidAtt)
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+				handler(writer, request)
+				require.Equal(t, http.StatusOK, writer.Code)
+				resp := &structs.ListAttestationsResponse{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+				var atts []*structs.Attestation
+				require.NoError(t, json.Unmarshal(resp.Data, &atts))
+				slots := make([]string, len(atts))
+				for i, a := range atts {
+					slots[i] = a.Data.Slot
+				}
+				return slots
+			}
+
+			assert.DeepEqual(t, []string{"1", "2", "3", "4"}, list(t, ""))
+			assert.DeepEqual(t, []string{"2", "3", "4"}, list(t, "source_epoch=1"))
+			assert.DeepEqual(t, []string{"1", "2"}, list(t, "target_epoch=1"))
+			assert.DeepEqual(t, []string{"2"}, list(t, "source_epoch=1&target_epoch=1"))
+			assert.DeepEqual(t, []string{"4"}, list(t, "target_epoch=2&slot=4"))
+			assert.DeepEqual(t, []string{}, list(t, "source_epoch=0&slot=2"))
+
+			for _, query := range []string{"source_epoch=foo", "target_epoch=-1"} {
+				request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
 				writer := httptest.NewRecorder()
 				writer.Body = &bytes.Buffer{}
 
-				s.SubmitAttestationsV2(writer, request)
+				handler(writer, request)
 				assert.Equal(t, http.StatusBadRequest, writer.Code)
-				e := &server.IndexedVerificationFailureError{}
-				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-				assert.Equal(t, http.StatusBadRequest, e.Code)
-				require.Equal(t, 1, len(e.Failures))
-				assert.Equal(t, true, strings.Contains(e.Failures[0].Message, "Incorrect attestation signature"))
-			})
+			}
 		})
-		t.Run("post-electra", func(t *testing.T) {
-			t.Run("single", func(t *testing.T) {
-				broadcaster := &p2pMock.MockBroadcaster{}
-				s.Broadcaster = broadcaster
-				s.AttestationsPool = attestations.NewPool()
+	}
+}
 
-				var body bytes.Buffer
-				_, err := body.WriteString(singleAttElectra)
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Electra))
-				writer := httptest.NewRecorder()
-				writer.Body = &bytes.Buffer{}
+func TestListAttestations_Pagination(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+	}
+	for slot := primitives.Slot(0); slot < 5; slot++ {
+		require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{
+			util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b11}, Data: &ethpbv1alpha1.AttestationData{Slot: slot}}),
+		}))
+	}
+	list := func(t *testing.T, handler http.HandlerFunc, query string) (*structs.ListAttestationsResponse, int) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
 
-				s.SubmitAttestationsV2(writer, request)
+		handler(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		return resp, len(atts)
+	}
 
-				assert.Equal(t, http.StatusOK, writer.Code)
-				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
-				assert.Equal(t, 1, broadcaster.NumAttestations())
-				assert.Equal(t, "0x03", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetAggregationBits()))
-				assert.Equal(t, "0x8146f4397bfd8fd057ebbcd6a67327bdc7ed5fb650533edcb6377b650dea0b6da64c14ecd60846d5c0a0cd43893d6972092500f82c9d8a955e2b58c5ed3cbe885d84008ace6bd86ba9e23652f58e2ec207cec494c916063257abf285b9b15b15", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetSignature()))
-				assert.Equal(t, primitives.Slot(0), broadcaster.BroadcastAttestations[0].GetData().Slot)
-				assert.Equal(t, primitives.CommitteeIndex(0), broadcaster.BroadcastAttestations[0].GetData().CommitteeIndex)
-				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().BeaconBlockRoot))
-				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Source.Root))
-				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Source.Epoch)
-				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Target.Root))
-				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Target.Epoch)
-				assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+	for name, handler := range map[string]http.HandlerFunc{"V1": s.ListAttestations, "V2": s.ListAttestationsV2} {
+		t.Run(name, func(t *testing.T) {
+			t.Run("no pagination", func(t *testing.T) {
+				resp, n := list(t, handler, "")
+				assert.Equal(t, "5", resp.Total)
+				assert.Equal(t, 5, n)
 			})
-			t.Run("multiple", func(t *testing.T) {
-				broadcaster := &p2pMock.MockBroadcaster{}
-				s.Broadcaster = broadcaster
-				s.AttestationsPool = attestations.NewPool()
-
-				var body bytes.Buffer
-				_, err := body.WriteString(multipleAttsElectra)
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Electra))
-				writer := httptest.NewRecorder()
-				writer.Body = &bytes.Buffer{}
-
-				s.SubmitAttestationsV2(writer, request)
-				assert.Equal(t, http.StatusOK, writer.Code)
-				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
-				assert.Equal(t, 2, broadcaster.NumAttestations())
-				assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
+			t.Run("limit and offset", func(t *testing.T) {
+				resp, n := list(t, handler, "limit=2&offset=1")
+				assert.Equal(t, "5", resp.Total)
+				assert.Equal(t, 2, n)
 			})
-			t.Run("no body", func(t *testing.T) {
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
-				request.Header.Set(api.VersionHeader, version.String(version.Electra))
-				writer := httptest.NewRecorder()
-				writer.Body = &bytes.Buffer{}
-
-				s.SubmitAttestationsV2(writer, request)
-				assert.Equal(t, http.StatusBadRequest, writer.Code)
-				e := &httputil.DefaultJsonError{}
-				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-				assert.Equal(t, http.StatusBadRequest, e.Code)
-				assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+			t.Run("last page", func(t *testing.T) {
+				resp, n := list(t, handler, "limit=2&offset=4")
+				assert.Equal(t, "5", resp.Total)
+				assert.Equal(t, 1, n)
 			})
-			t.Run("empty", func(t *testing.T) {
-				var body bytes.Buffer
-				_, err := body.WriteString("[]")
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Electra))
-				writer := httptest.NewRecorder()
-				writer.Body = &bytes.Buffer{}
-
-				s.SubmitAttestationsV2(writer, request)
-				assert.Equal(t, http.StatusBadRequest, writer.Code)
-				e := &httputil.DefaultJsonError{}
-				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-				assert.Equal(t, http.StatusBadRequest, e.Code)
-				assert.Equal(t, true, strings.Contains(e.Message, "no data submitted"))
+			t.Run("offset only", func(t *testing.T) {
+				_, n := list(t, handler, "offset=2")
+				assert.Equal(t, 3, n)
 			})
-			t.Run("invalid", func(t *testing.T) {
-				var body bytes.Buffer
-				_, err := body.WriteString(invalidAttElectra)
-				require.NoError(t, err)
-				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
-				request.Header.Set(api.VersionHeader, version.String(version.Electra))
-				writer := httptest.NewRecorder()
-				writer.Body = &bytes.Buffer{}
-
-				s.SubmitAttestationsV2(writer, request)
-				assert.Equal(t, http.StatusBadRequest, writer.Code)
-				e := &server.IndexedVerificationFailureError{}
-				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-				assert.Equal(t, http.StatusBadRequest, e.Code)
-				require.Equal(t, 1, len(e.Failures))
-				assert.Equal(t, true, strings.Contains(e.Failures[0].Message, "Incorrect attestation signature"))
+			t.Run("offset beyond total", func(t *testing.T) {
+				resp, n := list(t, handler, "limit=2&offset=10")
+				assert.Equal(t, "5", resp.Total)
+				assert.Equal(t, "[]", string(resp.Data))
+				assert.Equal(t, 0, n)
 			})
+			t.Run("total counts matching attestations", func(t *testing.T) {
+				resp, n := list(t, handler, "since_slot=1&limit=1")
+				assert.Equal(t, "3", resp.Total)
+				assert.Equal(t, 1, n)
+			})
+		})
+	}
+}
+
+func TestDedupAttestations(t *testing.T) {
+	att := func(slot primitives.Slot, bits byte) *ethpbv1alpha1.Attestation {
+		return util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+			AggregationBits: bitfield.Bitlist{bits},
+			Data:            &ethpbv1alpha1.AttestationData{Slot: slot},
+		})
+	}
+	attElectra := func(committee uint64) *ethpbv1alpha1.AttestationElectra {
+		committeeBits := primitives.NewAttestationCommitteeBits()
+		committeeBits.SetBitAt(committee, true)
+		return util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{
+			AggregationBits: bitfield.Bitlist{0b11},
+			Data:            &ethpbv1alpha1.AttestationData{Slot: 1},
+			CommitteeBits:   committeeBits,
 		})
+	}
+
+	t.Run("duplicates are removed", func(t *testing.T) {
+		deduped, err := dedupAttestations([]ethpbv1alpha1.Att{att(2, 0b111), att(1, 0b11), att(2, 0b111), att(1, 0b11)})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(deduped))
+		assert.Equal(t, primitives.Slot(1), deduped[0].GetData().Slot)
+		assert.Equal(t, primitives.Slot(2), deduped[1].GetData().Slot)
+	})
+	t.Run("covered attestations are removed", func(t *testing.T) {
+		deduped, err := dedupAttestations([]ethpbv1alpha1.Att{att(1, 0b1001), att(1, 0b1011), att(1, 0b1100), att(2, 0b1001)})
+		require.NoError(t, err)
+		require.Equal(t, 3, len(deduped))
+		assert.DeepEqual(t, bitfield.Bitlist{0b1011}, deduped[0].GetAggregationBits())
+		assert.DeepEqual(t, bitfield.Bitlist{0b1100}, deduped[1].GetAggregationBits())
+		assert.Equal(t, primitives.Slot(2), deduped[2].GetData().Slot)
+	})
+	t.Run("electra committee bits are compared", func(t *testing.T) {
+		deduped, err := dedupAttestations([]ethpbv1alpha1.Att{attElectra(0), attElectra(1), attElectra(0)})
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(deduped))
+	})
+	t.Run("stable order", func(t *testing.T) {
+		atts := []ethpbv1alpha1.Att{att(3, 0b11), att(1, 0b101), att(1, 0b11), att(1, 0b110)}
+		first, err := dedupAttestations(atts)
+		require.NoError(t, err)
+		slices.Reverse(atts)
+		second, err := dedupAttestations(atts)
+		require.NoError(t, err)
+		require.Equal(t, len(first), len(second))
+		for i := range first {
+			assert.DeepEqual(t, first[i], second[i])
+		}
+		assert.Equal(t, primitives.Slot(3), first[3].GetData().Slot)
+	})
+}
+
+func TestListAttestationsByAggregator(t *testing.T) {
+	att1 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 1},
+	})
+	att2 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1011},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 2},
+	})
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatorAttestation(5, att1))
+	require.NoError(t, s.AttestationsPool.SaveAggregatorAttestation(6, att2))
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?aggregator_index=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestationsByAggregator(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		require.Equal(t, 1, len(atts))
+		assert.Equal(t, "1", atts[0].Data.Slot)
+		assert.Equal(t, "phase0", resp.Version)
 	})
+	t.Run("unknown aggregator", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?aggregator_index=7", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestationsByAggregator(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		assert.Equal(t, 0, len(atts))
+	})
+	t.Run("missing aggregator index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
 
+		s.ListAttestationsByAggregator(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "aggregator_index is required", e.Message)
+	})
 }
 
-func TestListVoluntaryExits(t *testing.T) {
-	exit1 := &ethpbv1alpha1.SignedVoluntaryExit{
-		Exit: &ethpbv1alpha1.VoluntaryExit{
-			Epoch:          1,
-			ValidatorIndex: 1,
-		},
-		Signature: bytesutil.PadTo([]byte("signature1"), 96),
+func TestListAttestationsByValidator(t *testing.T) {
+	// Committees computed from the test state must not leak into other tests through the committee cache.
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+		state.Validators = make([]*ethpbv1alpha1.Validator, 64)
+		for i := range state.Validators {
+			state.Validators[i] = &ethpbv1alpha1.Validator{
+				PublicKey:        make([]byte, fieldparams.BLSPubkeyLength),
+				EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+				ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), bs, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+
+	bits1 := bitfield.NewBitlist(uint64(len(committee)))
+	bits1.SetBitAt(0, true)
+	att1 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits1,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root1"), 32)},
+	})
+	bits2 := bitfield.NewBitlist(uint64(len(committee)))
+	bits2.SetBitAt(1, true)
+	att2 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits2,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root2"), 32)},
+	})
+	// The committee index is out of range, so the committee of the attestation cannot be computed.
+	unknownCommittee := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits1,
+		Data:            &ethpbv1alpha1.AttestationData{CommitteeIndex: 100, BeaconBlockRoot: bytesutil.PadTo([]byte("root3"), 32)},
+	})
+	committeeBits := primitives.NewAttestationCommitteeBits()
+	committeeBits.SetBitAt(0, true)
+	// The head state is pre-Electra, so Electra attestations are not listed.
+	electra := util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{
+		AggregationBits: bits1,
+		CommitteeBits:   committeeBits,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root4"), 32)},
+	})
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
 	}
-	exit2 := &ethpbv1alpha1.SignedVoluntaryExit{
-		Exit: &ethpbv1alpha1.VoluntaryExit{
-			Epoch:          2,
-			ValidatorIndex: 2,
-		},
-		Signature: bytesutil.PadTo([]byte("signature2"), 96),
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{att1, att2, unknownCommittee, electra}))
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com?validator_index=%d", committee[0]), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestationsByValidator(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.String(version.Phase0), resp.Version)
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		require.Equal(t, 1, len(atts))
+		assert.Equal(t, hexutil.Encode(att1.Data.BeaconBlockRoot), atts[0].Data.BeaconBlockRoot)
+	})
+	t.Run("validator not in committee", func(t *testing.T) {
+		var outsider primitives.ValidatorIndex
+		for outsider = 0; outsider < 64; outsider++ {
+			if !slices.Contains(committee, outsider) {
+				break
+			}
+		}
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com?validator_index=%d", outsider), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestationsByValidator(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		assert.Equal(t, 0, len(atts))
+	})
+	t.Run("missing validator index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestationsByValidator(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "validator_index is required", e.Message)
+	})
+}
+
+func TestGetBestAttestation(t *testing.T) {
+	newAtt := func(slot primitives.Slot, committeeIndex primitives.CommitteeIndex, blockRoot string, bits bitfield.Bitlist) *ethpbv1alpha1.Attestation {
+		return util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+			AggregationBits: bits,
+			Data: &ethpbv1alpha1.AttestationData{
+				Slot:            slot,
+				CommitteeIndex:  committeeIndex,
+				BeaconBlockRoot: bytesutil.PadTo([]byte(blockRoot), 32),
+			},
+		})
+	}
+	best := newAtt(1, 1, "best", bitfield.Bitlist{0b11110})
+	s := &Server{AttestationsPool: attestations.NewPool()}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{
+		newAtt(1, 1, "worse", bitfield.Bitlist{0b10110}),
+		best,
+		newAtt(1, 2, "other committee", bitfield.Bitlist{0b11111}),
+		newAtt(2, 1, "other slot", bitfield.Bitlist{0b11111}),
+	}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{newAtt(3, 1, "unaggregated", bitfield.Bitlist{0b10010})}))
+
+	get := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetBestAttestation(writer, request)
+		return writer
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		writer := get(t, "slot=1&committee_index=1")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBestAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "phase0", resp.Version)
+		att := &structs.Attestation{}
+		require.NoError(t, json.Unmarshal(resp.Data, att))
+		assert.DeepEqual(t, structs.AttFromConsensus(best), att)
+	})
+	t.Run("unaggregated only", func(t *testing.T) {
+		writer := get(t, "slot=3&committee_index=1")
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("no match", func(t *testing.T) {
+		writer := get(t, "slot=1&committee_index=3")
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No matching aggregated attestation found", e.Message)
+	})
+	t.Run("missing committee index", func(t *testing.T) {
+		writer := get(t, "slot=1")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetPoolAttestation(t *testing.T) {
+	s := &Server{AttestationsPool: attestations.NewPool()}
+	committeeBits := primitives.NewAttestationCommitteeBits()
+	committeeBits.SetBitAt(1, true)
+	attElectra := util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{
+		AggregationBits: bitfield.Bitlist{0b11},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 4},
+		CommitteeBits:   committeeBits,
+	})
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(1, 0, 1, 2)}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(1, 3), poolAttestation(2, 0), attElectra}))
+
+	get := func(t *testing.T, root string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("attestation_data_root", root)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPoolAttestation(writer, request)
+		return writer
 	}
+	dataRoot := func(t *testing.T, a ethpbv1alpha1.Att) string {
+		root, err := a.GetData().HashTreeRoot()
+		require.NoError(t, err)
+		return hexutil.Encode(root[:])
+	}
+
+	t.Run("most participants", func(t *testing.T) {
+		writer := get(t, dataRoot(t, poolAttestation(1)))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPoolAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "phase0", resp.Version)
+		a := &structs.Attestation{}
+		require.NoError(t, json.Unmarshal(resp.Data, a))
+		assert.Equal(t, hexutil.Encode(poolAttestation(1, 0, 1, 2).AggregationBits), a.AggregationBits)
+	})
+	t.Run("unaggregated", func(t *testing.T) {
+		writer := get(t, dataRoot(t, poolAttestation(2)))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPoolAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		a := &structs.Attestation{}
+		require.NoError(t, json.Unmarshal(resp.Data, a))
+		assert.Equal(t, "2", a.Data.Slot)
+	})
+	t.Run("electra", func(t *testing.T) {
+		writer := get(t, dataRoot(t, attElectra))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPoolAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "electra", resp.Version)
+		a := &structs.AttestationElectra{}
+		require.NoError(t, json.Unmarshal(resp.Data, a))
+		assert.Equal(t, hexutil.Encode(committeeBits), a.CommitteeBits)
+	})
+	t.Run("not found", func(t *testing.T) {
+		writer := get(t, dataRoot(t, poolAttestation(3)))
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No pooled attestation found with data root", e.Message)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		for _, root := range []string{"0xfoo", "0x1234"} {
+			writer := get(t, root)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "attestation_data_root", e.Message)
+		}
+	})
+}
+
+func TestListPrunableAttestations(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	c.DenebForkEpoch = 0
+	params.OverrideBeaconConfig(c)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerEpoch := time.Duration(uint64(slotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
 
+	newAtt := func(slot primitives.Slot, bits bitfield.Bitlist) *ethpbv1alpha1.Attestation {
+		return util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bits, Data: &ethpbv1alpha1.AttestationData{Slot: slot}})
+	}
+	expiredAgg := newAtt(0, bitfield.Bitlist{0b1101})
+	expiredUnagg := newAtt(slotsPerEpoch-1, bitfield.Bitlist{0b1001})
+	// The current epoch is 2, so attestations from epoch 1 are retained.
 	s := &Server{
-		VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2}},
+		AttestationsPool:   attestations.NewPool(),
+		GenesisTimeFetcher: &blockchainmock.ChainService{Genesis: time.Now().Add(-2*secondsPerEpoch - time.Second)},
 	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{expiredAgg, newAtt(slotsPerEpoch, bitfield.Bitlist{0b1101})}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{expiredUnagg, newAtt(2*slotsPerEpoch, bitfield.Bitlist{0b1001})}))
 
 	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
 	writer := httptest.NewRecorder()
 	writer.Body = &bytes.Buffer{}
 
-	s.ListVoluntaryExits(writer, request)
-	assert.Equal(t, http.StatusOK, writer.Code)
-	resp := &structs.ListVoluntaryExitsResponse{}
+	s.ListPrunableAttestations(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.ListAttestationsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	var atts []*structs.Attestation
+	require.NoError(t, json.Unmarshal(resp.Data, &atts))
+	require.Equal(t, 2, len(atts))
+	slotsSeen := map[string]bool{atts[0].Data.Slot: true, atts[1].Data.Slot: true}
+	assert.Equal(t, true, slotsSeen["0"])
+	assert.Equal(t, true, slotsSeen[fmt.Sprintf("%d", slotsPerEpoch-1)])
+	// Nothing is pruned.
+	assert.Equal(t, 2, s.AttestationsPool.AggregatedAttestationCount())
+	assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
+}
+
+func TestGetAttestationPoolFormatCounts(t *testing.T) {
+	committeeBits := primitives.NewAttestationCommitteeBits()
+	committeeBits.SetBitAt(0, true)
+	s := &Server{AttestationsPool: attestations.NewPool()}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b1101}}),
+		util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{
+			AggregationBits: bitfield.Bitlist{0b1101},
+			CommitteeBits:   committeeBits,
+			Data:            &ethpbv1alpha1.AttestationData{Slot: 3},
+		}),
+	}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b1001}, Data: &ethpbv1alpha1.AttestationData{Slot: 1}}),
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b1001}, Data: &ethpbv1alpha1.AttestationData{Slot: 2}}),
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetAttestationPoolFormatCounts(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetAttestationPoolFormatCountsResponse{}
 	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
-	require.NotNil(t, resp)
 	require.NotNil(t, resp.Data)
-	require.Equal(t, 2, len(resp.Data))
-	assert.Equal(t, "0x7369676e6174757265310000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", resp.Data[0].Signature)
-	assert.Equal(t, "1", resp.Data[0].Message.Epoch)
-	assert.Equal(t, "1", resp.Data[0].Message.ValidatorIndex)
-	assert.Equal(t, "0x7369676e6174757265320000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", resp.Data[1].Signature)
-	assert.Equal(t, "2", resp.Data[1].Message.Epoch)
-	assert.Equal(t, "2", resp.Data[1].Message.ValidatorIndex)
+	assert.Equal(t, "3", resp.Data.PreElectra)
+	assert.Equal(t, "1", resp.Data.Electra)
 }
 
-func TestSubmitVoluntaryExit(t *testing.T) {
-	transition.SkipSlotCache.Disable()
-	defer transition.SkipSlotCache.Enable()
+func TestGetPoolStats(t *testing.T) {
+	s := &Server{
+		AttestationsPool: attestations.NewPool(),
+		VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{
+			{Exit: &ethpbv1alpha1.VoluntaryExit{ValidatorIndex: 1}},
+			{Exit: &ethpbv1alpha1.VoluntaryExit{ValidatorIndex: 2}},
+		}},
+		SlashingsPool: &slashingsmock.PoolMock{
+			PendingAttSlashings:  []ethpbv1alpha1.AttSlashing{&ethpbv1alpha1.AttesterSlashing{}},
+			PendingPropSlashings: []*ethpbv1alpha1.ProposerSlashing{{}, {}, {}},
+		},
+		BLSChangesPool: &blstoexecmock.PoolMock{Changes: []*ethpbv1alpha1.SignedBLSToExecutionChange{{}, {}, {}, {}}},
+	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b1101}}),
+	}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b1001}, Data: &ethpbv1alpha1.AttestationData{Slot: 1}}),
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b1001}, Data: &ethpbv1alpha1.AttestationData{Slot: 2}}),
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/stats", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetPoolStats(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetPoolStatsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, "1", resp.Data.AggregatedAttestations)
+	assert.Equal(t, "2", resp.Data.UnaggregatedAttestations)
+	assert.Equal(t, "2", resp.Data.VoluntaryExits)
+	assert.Equal(t, "1", resp.Data.AttesterSlashings)
+	assert.Equal(t, "3", resp.Data.ProposerSlashings)
+	assert.Equal(t, "4", resp.Data.BLSToExecutionChanges)
+}
+
+func TestGetAttestationPoolAge(t *testing.T) {
+	genesis := time.Now().Add(-10 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s := &Server{
+		GenesisTimeFetcher: &blockchainmock.ChainService{Genesis: genesis},
+		AttestationsPool:   attestations.NewPool(),
+	}
+
+	t.Run("empty pool", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
 
+		s.GetAttestationPoolAge(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
 	t.Run("ok", func(t *testing.T) {
+		require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(8, 0, 1)}))
+		require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(10, 0), poolAttestation(3, 0)}))
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationPoolAge(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetAttestationPoolAgeResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "3", resp.Data.OldestSlot)
+		assert.Equal(t, "7", resp.Data.AgeSlots)
+		assert.Equal(t, fmt.Sprintf("%d", 7*params.BeaconConfig().SecondsPerSlot), resp.Data.AgeSeconds)
+	})
+}
+
+func TestGetAttestationPoolValidatorCount(t *testing.T) {
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+	bs, _ := util.DeterministicGenesisState(t, 128)
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), bs, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 2)
+
+	bits1 := bitfield.NewBitlist(uint64(len(committee)))
+	bits1.SetBitAt(0, true)
+	bits1.SetBitAt(1, true)
+	att1 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits1,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root1"), 32)},
+	})
+	bits2 := bitfield.NewBitlist(uint64(len(committee)))
+	bits2.SetBitAt(1, true)
+	att2 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits2,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root2"), 32)},
+	})
+	bits3 := bitfield.NewBitlist(uint64(len(committee)))
+	bits3.SetBitAt(2, true)
+	att3 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits3,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root1"), 32)},
+	})
+	// The committee index is out of range, so the attestation is skipped instead of failing the request.
+	unknownCommittee := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits3,
+		Data:            &ethpbv1alpha1.AttestationData{CommitteeIndex: 100, BeaconBlockRoot: bytesutil.PadTo([]byte("root3"), 32)},
+	})
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att1}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{att2, att3, unknownCommittee}))
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetAttestationPoolValidatorCount(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetAttestationPoolValidatorCountResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	// The validator at committee position 1 participated in two attestations but is only counted once.
+	assert.Equal(t, "3", resp.Data.ValidatorCount)
+}
+
+func TestGetAttestationPoolCoverage(t *testing.T) {
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+	bs, _ := util.DeterministicGenesisState(t, 128)
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), bs, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 3)
+
+	bits1 := bitfield.NewBitlist(uint64(len(committee)))
+	bits1.SetBitAt(0, true)
+	bits1.SetBitAt(1, true)
+	att1 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits1,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root1"), 32)},
+	})
+	bits2 := bitfield.NewBitlist(uint64(len(committee)))
+	bits2.SetBitAt(2, true)
+	att2 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits2,
+		Data:            &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte("root2"), 32)},
+	})
+	// An attestation for another slot does not contribute to the coverage.
+	bits3 := bitfield.NewBitlist(uint64(len(committee)))
+	bits3.SetBitAt(3, true)
+	att3 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bits3,
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 1},
+	})
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att1}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{att2, att3}))
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?slot=0", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationPoolCoverage(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetAttestationPoolCoverageResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		expected := bitfield.NewBitlist(uint64(len(committee)))
+		expected.SetBitAt(0, true)
+		expected.SetBitAt(1, true)
+		expected.SetBitAt(2, true)
+		assert.Equal(t, "0", resp.Data[0].CommitteeIndex)
+		assert.Equal(t, fmt.Sprintf("%d", len(committee)), resp.Data[0].CommitteeSize)
+		assert.Equal(t, "3", resp.Data[0].Covered)
+		assert.Equal(t, hexutil.Encode(expected), resp.Data[0].Coverage)
+	})
+	t.Run("missing slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationPoolCoverage(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestAddAttestationCoverage(t *testing.T) {
+	coverage := []bitfield.Bitlist{bitfield.NewBitlist(2), bitfield.NewBitlist(3), bitfield.NewBitlist(2)}
+	committeeBits := primitives.NewAttestationCommitteeBits()
+	committeeBits.SetBitAt(1, true)
+	committeeBits.SetBitAt(2, true)
+	// The aggregation bits span committee 1 followed by committee 2.
+	bits := bitfield.NewBitlist(5)
+	bits.SetBitAt(1, true)
+	bits.SetBitAt(4, true)
+	att := util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{AggregationBits: bits, CommitteeBits: committeeBits})
+	require.NoError(t, addAttestationCoverage(coverage, att))
+	assert.Equal(t, uint64(0), coverage[0].Count())
+	assert.DeepEqual(t, []int{1}, coverage[1].BitIndices())
+	assert.DeepEqual(t, []int{1}, coverage[2].BitIndices())
+
+	att.AggregationBits = bitfield.NewBitlist(4)
+	assert.ErrorContains(t, "does not match committees size", addAttestationCoverage(coverage, att))
+}
+
+func TestGetAttestationPoolSlotHistogram(t *testing.T) {
+	genesis := time.Now().Add(-10 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s := &Server{
+		GenesisTimeFetcher: &blockchainmock.ChainService{Genesis: genesis},
+		AttestationsPool:   attestations.NewPool(),
+	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(8, 0, 1)}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(8, 2), poolAttestation(10, 0), poolAttestation(3, 0)}))
+
+	histogram := func(t *testing.T, query string) (int, *structs.GetAttestationPoolSlotHistogramResponse) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationPoolSlotHistogram(writer, request)
+		resp := &structs.GetAttestationPoolSlotHistogramResponse{}
+		if writer.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		}
+		return writer.Code, resp
+	}
+
+	t.Run("window", func(t *testing.T) {
+		code, resp := histogram(t, "?window=4")
+		require.Equal(t, http.StatusOK, code)
+		assert.DeepEqual(t, map[string]string{"7": "0", "8": "2", "9": "0", "10": "1"}, resp.Data)
+	})
+	t.Run("default window starts at genesis", func(t *testing.T) {
+		code, resp := histogram(t, "")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 11, len(resp.Data))
+		assert.Equal(t, "1", resp.Data["3"])
+		assert.Equal(t, "0", resp.Data["0"])
+	})
+	t.Run("invalid window", func(t *testing.T) {
+		code, _ := histogram(t, "?window=0")
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = histogram(t, fmt.Sprintf("?window=%d", 4*params.BeaconConfig().SlotsPerEpoch+1))
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestGetAttestationPoolDiff(t *testing.T) {
+	att1 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 1},
+	})
+	att2 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1011},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 2},
+	})
+	att3 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 3},
+	})
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	s := &Server{
+		ChainInfoFetcher:         &blockchainmock.ChainService{State: bs},
+		AttestationsPool:         attestations.NewPool(),
+		AttestationPoolSnapshots: NewAttestationPoolSnapshots(),
+	}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att1, att2}))
+
+	getDiff := func(t *testing.T, url string) *structs.AttestationPoolDiffResponse {
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationPoolDiff(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.AttestationPoolDiffResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
+	first := getDiff(t, "http://example.com")
+	var added []*structs.Attestation
+	require.NoError(t, json.Unmarshal(first.Added, &added))
+	assert.Equal(t, 2, len(added))
+	assert.Equal(t, 0, len(first.Removed))
+	assert.NotEqual(t, "", first.Token)
+
+	require.NoError(t, s.AttestationsPool.DeleteAggregatedAttestation(att1))
+	_, err = s.AttestationsPool.SaveAggregatedAttestation(att3)
+	require.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		resp := getDiff(t, "http://example.com?token="+first.Token)
+		var added []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Added, &added))
+		require.Equal(t, 1, len(added))
+		assert.Equal(t, "3", added[0].Data.Slot)
+		root, err := att1.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Removed))
+		assert.Equal(t, hexutil.Encode(root[:]), resp.Removed[0])
+		assert.NotEqual(t, first.Token, resp.Token)
+	})
+	t.Run("unknown token", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?token=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationPoolDiff(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Snapshot token is unknown or has expired", e.Message)
+	})
+}
+
+func TestAttestationPoolSnapshots_Bounds(t *testing.T) {
+	maxSnapshots, maxRoots := maxAttestationPoolSnapshots, maxAttestationPoolSnapshotRoots
+	maxAttestationPoolSnapshots, maxAttestationPoolSnapshotRoots = 2, 3
+	defer func() {
+		maxAttestationPoolSnapshots, maxAttestationPoolSnapshotRoots = maxSnapshots, maxRoots
+	}()
+	root := func(b byte) [32]byte { return [32]byte{b} }
+
+	t.Run("snapshot count", func(t *testing.T) {
+		snapshots := NewAttestationPoolSnapshots()
+		first := snapshots.Save([][32]byte{root(1)})
+		second := snapshots.Save([][32]byte{root(2)})
+		_, ok := snapshots.Get(first)
+		require.Equal(t, true, ok)
+		third := snapshots.Save([][32]byte{root(3)})
+		_, ok = snapshots.Get(second)
+		assert.Equal(t, false, ok)
+		_, ok = snapshots.Get(first)
+		assert.Equal(t, true, ok)
+		snapshot, ok := snapshots.Get(third)
+		require.Equal(t, true, ok)
+		assert.Equal(t, true, snapshot.Contains(root(3)))
+		assert.Equal(t, false, snapshot.Contains(root(1)))
+	})
+	t.Run("root count", func(t *testing.T) {
+		snapshots := NewAttestationPoolSnapshots()
+		first := snapshots.Save([][32]byte{root(3), root(1)})
+		second := snapshots.Save([][32]byte{root(2), root(4)})
+		_, ok := snapshots.Get(first)
+		assert.Equal(t, false, ok)
+		snapshot, ok := snapshots.Get(second)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, attestationPoolSnapshot{root(2), root(4)}, snapshot)
+	})
+	t.Run("snapshot too large", func(t *testing.T) {
+		snapshots := NewAttestationPoolSnapshots()
+		assert.Equal(t, "", snapshots.Save([][32]byte{root(1), root(2), root(3), root(4)}))
+	})
+}
+
+func TestListAttestationVotesByBlockRoot(t *testing.T) {
+	rootA := bytesutil.PadTo([]byte("a"), 32)
+	rootB := bytesutil.PadTo([]byte("b"), 32)
+	att1 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 1, BeaconBlockRoot: rootB},
+	})
+	att2 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1011},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 2, BeaconBlockRoot: rootB},
+	})
+	att3 := util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b0101},
+		Data:            &ethpbv1alpha1.AttestationData{Slot: 1, BeaconBlockRoot: rootA},
+	})
+	s := &Server{AttestationsPool: attestations.NewPool()}
+
+	t.Run("empty pool", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestationVotesByBlockRoot(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationVotesByBlockRootResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("ok", func(t *testing.T) {
+		require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{att1, att2}))
+		_, err := s.AttestationsPool.SaveUnaggregatedAttestation(att3)
+		require.NoError(t, err)
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestationVotesByBlockRoot(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListAttestationVotesByBlockRootResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, hexutil.Encode(rootB), resp.Data[0].BlockRoot)
+		assert.Equal(t, "4", resp.Data[0].ParticipantCount)
+		assert.Equal(t, "2", resp.Data[0].AttestationCount)
+		assert.Equal(t, hexutil.Encode(rootA), resp.Data[1].BlockRoot)
+		assert.Equal(t, "1", resp.Data[1].ParticipantCount)
+		assert.Equal(t, "1", resp.Data[1].AttestationCount)
+	})
+}
+
+type mockAttestationPacker struct {
+	atts []ethpbv1alpha1.Att
+	slot primitives.Slot
+}
+
+func (m *mockAttestationPacker) PackAttestations(_ context.Context, slot primitives.Slot) ([]ethpbv1alpha1.Att, error) {
+	m.slot = slot
+	return m.atts, nil
+}
+
+func TestGetAttestationsForInclusion(t *testing.T) {
+	att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{Data: &ethpbv1alpha1.AttestationData{Slot: 4, CommitteeIndex: 1}})
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, bs.SetSlot(3))
+	currentSlot := primitives.Slot(5)
+	chainService := &blockchainmock.ChainService{State: bs, Slot: &currentSlot}
+
+	get := func(t *testing.T, packer *mockAttestationPacker, url string) *httptest.ResponseRecorder {
+		s := &Server{
+			ChainInfoFetcher:   chainService,
+			GenesisTimeFetcher: chainService,
+			AttestationPacker:  packer,
+		}
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetAttestationsForInclusion(writer, request)
+		return writer
+	}
+
+	t.Run("next slot", func(t *testing.T) {
+		packer := &mockAttestationPacker{atts: []ethpbv1alpha1.Att{att}}
+		writer := get(t, packer, "http://example.com/prysm/v1/beacon/pool/attestations/inclusion")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, primitives.Slot(6), packer.slot)
+		resp := &structs.GetAttestationsForInclusionResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "phase0", resp.Version)
+		assert.Equal(t, "6", resp.Slot)
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		require.Equal(t, 1, len(atts))
+		assert.Equal(t, "4", atts[0].Data.Slot)
+		assert.Equal(t, "1", atts[0].Data.CommitteeIndex)
+	})
+	t.Run("requested slot", func(t *testing.T) {
+		packer := &mockAttestationPacker{}
+		writer := get(t, packer, "http://example.com/prysm/v1/beacon/pool/attestations/inclusion?slot=4")
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, primitives.Slot(4), packer.slot)
+		resp := &structs.GetAttestationsForInclusionResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "4", resp.Slot)
+		assert.Equal(t, "[]", string(resp.Data))
+	})
+	t.Run("slot not after head", func(t *testing.T) {
+		writer := get(t, &mockAttestationPacker{}, "http://example.com/prysm/v1/beacon/pool/attestations/inclusion?slot=3")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Slot 3 is not after the head slot 3", e.Message)
+	})
+	t.Run("slot after next slot", func(t *testing.T) {
+		packer := &mockAttestationPacker{}
+		writer := get(t, packer, "http://example.com/prysm/v1/beacon/pool/attestations/inclusion?slot=7")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Equal(t, primitives.Slot(0), packer.slot)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Slot 7 is after the next slot 6", e.Message)
+	})
+}
+
+func TestGetEligibleAttestations(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	c.DenebForkEpoch = 2
+	c.ElectraForkEpoch = params.BeaconConfig().FarFutureEpoch
+	params.OverrideBeaconConfig(c)
+
+	s := &Server{AttestationsPool: attestations.NewPool()}
+	require.NoError(t, s.AttestationsPool.SaveAggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(10, 0, 1), poolAttestation(64, 0, 1), poolAttestation(65, 0, 1, 2)}))
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{poolAttestation(0, 0), poolAttestation(40, 0)}))
+
+	getEligible := func(t *testing.T, url string) (*httptest.ResponseRecorder, []*structs.Attestation) {
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetEligibleAttestations(writer, request)
+		if writer.Code != http.StatusOK {
+			return writer, nil
+		}
+		resp := &structs.GetEligibleAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "phase0", resp.Version)
+		var atts []*structs.Attestation
+		require.NoError(t, json.Unmarshal(resp.Data, &atts))
+		return writer, atts
+	}
+
+	t.Run("pre-Deneb inclusion window", func(t *testing.T) {
+		_, atts := getEligible(t, "http://example.com?target_slot=40")
+		require.Equal(t, 1, len(atts))
+		assert.Equal(t, "10", atts[0].Data.Slot)
+	})
+	t.Run("Deneb inclusion window", func(t *testing.T) {
+		_, atts := getEligible(t, "http://example.com?target_slot=100")
+		require.Equal(t, 2, len(atts))
+		assert.Equal(t, "65", atts[0].Data.Slot)
+		assert.Equal(t, "64", atts[1].Data.Slot)
+	})
+	t.Run("missing target slot", func(t *testing.T) {
+		writer, _ := getEligible(t, "http://example.com")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestListBroadcastFailures(t *testing.T) {
+	defaultMax := maxBroadcastFailures
+	maxBroadcastFailures = 2
+	defer func() {
+		maxBroadcastFailures = defaultMax
+	}()
+
+	s := &Server{BroadcastFailures: NewBroadcastFailures()}
+	att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{})
+	s.recordAttestationBroadcastFailure(0, att, nil, errors.New("first"))
+	subnet := uint64(5)
+	s.recordAttestationBroadcastFailure(1, att, &subnet, errors.New("second"))
+	s.recordAttestationBroadcastFailure(2, att, &subnet, errors.New("third"))
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.ListBroadcastFailures(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.ListBroadcastFailuresResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	// The oldest failure is evicted once the buffer is full.
+	require.Equal(t, 2, len(resp.Data))
+	root, err := att.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, "attestation", resp.Data[0].Operation)
+	assert.Equal(t, "1", resp.Data[0].Index)
+	assert.Equal(t, hexutil.Encode(root[:]), resp.Data[0].Root)
+	assert.Equal(t, "5", resp.Data[0].Subnet)
+	assert.Equal(t, "second", resp.Data[0].Error)
+	assert.Equal(t, "2", resp.Data[1].Index)
+	assert.Equal(t, "third", resp.Data[1].Error)
+}
+
+func TestGetSubmissionRejections(t *testing.T) {
+	t.Run("counts per category", func(t *testing.T) {
+		s := &Server{SubmissionRejections: NewSubmissionRejections()}
+		s.recordSubmissionRejections([]*server.IndexedVerificationFailure{
+			{Index: 0, Message: "Could not convert request attestation to consensus attestation: bad"},
+			{Index: 1, Message: "Incorrect attestation signature: bad"},
+			{Index: 2, Message: "Could not validate signature: bad"},
+			{Index: 3, Message: "validator index 5 is out of bounds, the registry contains 4 validators"},
+			{Index: 4, Message: "Attestation is too old: bad"},
+		}, 2)
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSubmissionRejections(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSubmissionRejectionsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "3600", resp.Window)
+		assert.Equal(t, "1", resp.Data[RejectionCategoryConversion])
+		assert.Equal(t, "2", resp.Data[RejectionCategorySignature])
+		assert.Equal(t, "1", resp.Data[RejectionCategoryOutOfRange])
+		assert.Equal(t, "2", resp.Data[RejectionCategoryBroadcast])
+		assert.Equal(t, "1", resp.Data[RejectionCategoryOther])
+	})
+	t.Run("rejections fall out of the window", func(t *testing.T) {
+		r := NewSubmissionRejections()
+		now := time.Now()
+		r.recordAt(now.Add(-submissionRejectionsWindow-submissionRejectionsBucket), RejectionCategorySignature, 3)
+		r.recordAt(now.Add(-submissionRejectionsWindow/2), RejectionCategorySignature, 2)
+		r.recordAt(now, RejectionCategorySignature, 1)
+		assert.Equal(t, uint64(3), r.countsAt(now)[RejectionCategorySignature])
+		assert.Equal(t, uint64(1), r.countsAt(now.Add(submissionRejectionsWindow/2 + submissionRejectionsBucket))[RejectionCategorySignature])
+	})
+	t.Run("nil counters", func(t *testing.T) {
+		s := &Server{}
+		s.recordSubmissionRejections([]*server.IndexedVerificationFailure{{Index: 0, Message: "bad"}}, 1)
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSubmissionRejections(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSubmissionRejectionsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0", resp.Data[RejectionCategoryOther])
+	})
+}
+
+func TestMarshalListData(t *testing.T) {
+	items := []interface{}{"aaaa", "bbbb", "cccc"}
+
+	t.Run("no limit", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		data, err := (&Server{}).marshalListData(writer, items, len(items))
+		require.NoError(t, err)
+		assert.Equal(t, `["aaaa","bbbb","cccc"]`, string(data))
+		assert.Equal(t, "", writer.Header().Get(api.ResponseTruncatedHeader))
+	})
+	t.Run("within limit", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		data, err := (&Server{MaxListResponseSize: 22}).marshalListData(writer, items, len(items))
+		require.NoError(t, err)
+		assert.Equal(t, `["aaaa","bbbb","cccc"]`, string(data))
+		assert.Equal(t, "", writer.Header().Get(api.ResponseTruncatedHeader))
+		assert.Equal(t, "", writer.Header().Get(api.TotalCountHeader))
+	})
+	t.Run("truncated", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		data, err := (&Server{MaxListResponseSize: 21}).marshalListData(writer, items, len(items))
+		require.NoError(t, err)
+		assert.Equal(t, `["aaaa","bbbb"]`, string(data))
+		assert.Equal(t, "true", writer.Header().Get(api.ResponseTruncatedHeader))
+		assert.Equal(t, "3", writer.Header().Get(api.TotalCountHeader))
+	})
+	t.Run("nothing fits", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		data, err := (&Server{MaxListResponseSize: 7}).marshalListData(writer, items, len(items))
+		require.NoError(t, err)
+		assert.Equal(t, `[]`, string(data))
+		assert.Equal(t, "3", writer.Header().Get(api.TotalCountHeader))
+	})
+	t.Run("paginated", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		data, err := (&Server{MaxListResponseSize: 21}).marshalListData(writer, items, 10)
+		require.NoError(t, err)
+		assert.Equal(t, `["aaaa","bbbb"]`, string(data))
+		assert.Equal(t, "10", writer.Header().Get(api.TotalCountHeader))
+	})
+}
+
+func TestShouldIncludeAttestation(t *testing.T) {
+	data := func(slot primitives.Slot, committeeIndex primitives.CommitteeIndex) *ethpbv1alpha1.AttestationData {
+		return &ethpbv1alpha1.AttestationData{Slot: slot, CommitteeIndex: committeeIndex}
+	}
+	ffgData := func(sourceEpoch, targetEpoch primitives.Epoch) *ethpbv1alpha1.AttestationData {
+		return &ethpbv1alpha1.AttestationData{
+			Slot:   1,
+			Source: &ethpbv1alpha1.Checkpoint{Epoch: sourceEpoch},
+			Target: &ethpbv1alpha1.Checkpoint{Epoch: targetEpoch},
+		}
+	}
+	committeeBits := func(indices ...uint64) []byte {
+		cb := primitives.NewAttestationCommitteeBits()
+		for _, i := range indices {
+			cb.SetBitAt(i, true)
+		}
+		return cb
+	}
+
+	tests := []struct {
+		name             string
+		att              ethpbv1alpha1.Att
+		rawSlot          string
+		slot             uint64
+		committeeIndices []primitives.CommitteeIndex
+		ffgEpochs        ffgEpochsFilter
+		want             bool
+	}{
+		{
+			name: "phase0 no filters",
+			att:  &ethpbv1alpha1.Attestation{Data: data(1, 3)},
+			want: true,
+		},
+		{
+			name:             "phase0 matching committee index",
+			att:              &ethpbv1alpha1.Attestation{Data: data(1, 3)},
+			committeeIndices: []primitives.CommitteeIndex{3},
+			want:             true,
+		},
+		{
+			name:             "phase0 different committee index",
+			att:              &ethpbv1alpha1.Attestation{Data: data(1, 3)},
+			committeeIndices: []primitives.CommitteeIndex{2},
+			want:             false,
+		},
+		{
+			name:             "phase0 one of multiple committee indices",
+			att:              &ethpbv1alpha1.Attestation{Data: data(1, 3)},
+			committeeIndices: []primitives.CommitteeIndex{2, 3},
+			want:             true,
+		},
+		{
+			name:             "phase0 none of multiple committee indices",
+			att:              &ethpbv1alpha1.Attestation{Data: data(1, 3)},
+			committeeIndices: []primitives.CommitteeIndex{1, 2},
+			want:             false,
+		},
+		{
+			name:             "phase0 committee index 0",
+			att:              &ethpbv1alpha1.Attestation{Data: data(1, 0)},
+			committeeIndices: []primitives.CommitteeIndex{0},
+			want:             true,
+		},
+		{
+			name:             "phase0 matching committee index different slot",
+			att:              &ethpbv1alpha1.Attestation{Data: data(1, 3)},
+			rawSlot:          "2",
+			slot:             2,
+			committeeIndices: []primitives.CommitteeIndex{3},
+			want:             false,
+		},
+		{
+			name:             "electra matching committee bit",
+			att:              &ethpbv1alpha1.AttestationElectra{Data: data(1, 0), CommitteeBits: committeeBits(3)},
+			committeeIndices: []primitives.CommitteeIndex{3},
+			want:             true,
+		},
+		{
+			name:             "electra data committee index is ignored",
+			att:              &ethpbv1alpha1.AttestationElectra{Data: data(1, 0), CommitteeBits: committeeBits(3)},
+			committeeIndices: []primitives.CommitteeIndex{0},
+			want:             false,
+		},
+		{
+			name:             "electra one of multiple committee bits",
+			att:              &ethpbv1alpha1.AttestationElectra{Data: data(1, 0), CommitteeBits: committeeBits(1, 2)},
+			committeeIndices: []primitives.CommitteeIndex{2},
+			want:             true,
+		},
+		{
+			name:             "electra committee index out of range",
+			att:              &ethpbv1alpha1.AttestationElectra{Data: data(1, 0), CommitteeBits: committeeBits(3)},
+			committeeIndices: []primitives.CommitteeIndex{100},
+			want:             false,
+		},
+		{
+			name:    "electra matching slot",
+			att:     &ethpbv1alpha1.AttestationElectra{Data: data(1, 0), CommitteeBits: committeeBits(3)},
+			rawSlot: "1",
+			slot:    1,
+			want:    true,
+		},
+		{
+			name:      "matching source and target epochs",
+			att:       &ethpbv1alpha1.Attestation{Data: ffgData(1, 2)},
+			ffgEpochs: ffgEpochsFilter{rawSource: "1", source: 1, rawTarget: "2", target: 2},
+			want:      true,
+		},
+		{
+			name:      "different source epoch",
+			att:       &ethpbv1alpha1.Attestation{Data: ffgData(1, 2)},
+			ffgEpochs: ffgEpochsFilter{rawSource: "0", source: 0},
+			want:      false,
+		},
+		{
+			name:      "different target epoch",
+			att:       &ethpbv1alpha1.AttestationElectra{Data: ffgData(1, 2), CommitteeBits: committeeBits(0)},
+			ffgEpochs: ffgEpochsFilter{rawTarget: "1", target: 1},
+			want:      false,
+		},
+		{
+			name:      "matching target epoch different slot",
+			att:       &ethpbv1alpha1.Attestation{Data: ffgData(1, 2)},
+			rawSlot:   "2",
+			slot:      2,
+			ffgEpochs: ffgEpochsFilter{rawTarget: "2", target: 2},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			committeeIndices := make(map[primitives.CommitteeIndex]struct{}, len(tt.committeeIndices))
+			for _, i := range tt.committeeIndices {
+				committeeIndices[i] = struct{}{}
+			}
+			got := shouldIncludeAttestation(tt.att, tt.rawSlot, tt.slot, committeeIndices, tt.ffgEpochs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSubmitAttestations(t *testing.T) {
+	transition.SkipSlotCache.Disable()
+	defer transition.SkipSlotCache.Enable()
+
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	// Required for correct committee size calculation.
+	c.SlotsPerEpoch = 1
+	params.OverrideBeaconConfig(c)
+
+	_, keys, err := util.DeterministicDepositsAndKeys(1)
+	require.NoError(t, err)
+	validators := []*ethpbv1alpha1.Validator{
+		{
+			PublicKey: keys[0].PublicKey().Marshal(),
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		},
+	}
+	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+		state.Validators = validators
+		state.Slot = 1
+		state.PreviousJustifiedCheckpoint = &ethpbv1alpha1.Checkpoint{
+			Epoch: 0,
+			Root:  bytesutil.PadTo([]byte("sourceroot1"), 32),
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	b := bitfield.NewBitlist(1)
+	b.SetBitAt(0, true)
+
+	chainService := &blockchainmock.ChainService{State: bs, Genesis: time.Now()}
+	s := &Server{
+		HeadFetcher:        chainService,
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		OperationNotifier:  &blockchainmock.MockOperationNotifier{},
+	}
+	t.Run("V1", func(t *testing.T) {
+		t.Run("single", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(singleAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+			assert.Equal(t, 1, broadcaster.NumAttestations())
+			assert.Equal(t, "0x03", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetAggregationBits()))
+			assert.Equal(t, "0x8146f4397bfd8fd057ebbcd6a67327bdc7ed5fb650533edcb6377b650dea0b6da64c14ecd60846d5c0a0cd43893d6972092500f82c9d8a955e2b58c5ed3cbe885d84008ace6bd86ba9e23652f58e2ec207cec494c916063257abf285b9b15b15", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetSignature()))
+			assert.Equal(t, primitives.Slot(0), broadcaster.BroadcastAttestations[0].GetData().Slot)
+			assert.Equal(t, primitives.CommitteeIndex(0), broadcaster.BroadcastAttestations[0].GetData().CommitteeIndex)
+			assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().BeaconBlockRoot))
+			assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Source.Root))
+			assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Source.Epoch)
+			assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Target.Root))
+			assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Target.Epoch)
+			assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+			assert.Equal(t, kv.AttestationSourceGossip, s.AttestationsPool.AttestationSource(broadcaster.BroadcastAttestations[0]))
+		})
+		t.Run("source tagging", func(t *testing.T) {
+			resetCfg := features.InitWithReset(&features.Flags{EnableAttestationSourceTagging: true})
+			defer resetCfg()
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(singleAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, 1, broadcaster.NumAttestations())
+			assert.Equal(t, kv.AttestationSourceRPC, s.AttestationsPool.AttestationSource(broadcaster.BroadcastAttestations[0]))
+		})
+		t.Run("broadcast deferred due to insufficient peers", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+			// The mock peers provider is connected to two peers.
+			s.PeersFetcher = &p2pMock.MockPeersProvider{}
+			s.MinAttestationBroadcastPeers = 3
+			defer func() {
+				s.PeersFetcher = nil
+				s.MinAttestationBroadcastPeers = 0
+			}()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(singleAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.SubmitAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			assert.Equal(t, true, resp.BroadcastDeferred)
+			assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+			assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		t.Run("receipt", func(t *testing.T) {
+			s.Broadcaster = &p2pMock.MockBroadcaster{}
+			s.AttestationsPool = attestations.NewPool()
+			s.AttestationReceipts = NewAttestationReceipts()
+			defer func() {
+				s.AttestationReceipts = nil
+			}()
+
+			// Join the entries of both fixtures into a single request.
+			body := strings.TrimSuffix(strings.TrimSpace(singleAtt), "]") + "," + strings.TrimPrefix(strings.TrimSpace(invalidAtt), "[")
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusAccepted, writer.Code)
+			receiptID := writer.Header().Get(api.ReceiptIDHeader)
+			require.NotEqual(t, "", receiptID)
+
+			request = httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			request.SetPathValue("receipt_id", receiptID)
+			writer = httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.GetAttestationReceipt(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.GetAttestationReceiptResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			require.NotNil(t, resp.Data)
+			assert.Equal(t, receiptID, resp.Data.ReceiptID)
+			require.Equal(t, 2, len(resp.Data.Outcomes))
+			// Validation failures are known before any attestation is broadcast.
+			assert.Equal(t, "1", resp.Data.Outcomes[0].Index)
+			assert.Equal(t, AttestationReceiptFailed, resp.Data.Outcomes[0].Status)
+			assert.StringContains(t, "Incorrect attestation signature", resp.Data.Outcomes[0].Message)
+			assert.Equal(t, "0", resp.Data.Outcomes[1].Index)
+			assert.Equal(t, AttestationReceiptBroadcast, resp.Data.Outcomes[1].Status)
+
+			t.Run("broadcast failure", func(t *testing.T) {
+				for _, save := range []bool{false, true} {
+					resetCfg := features.InitWithReset(&features.Flags{SaveBroadcastFailedAttestations: save})
+					s.Broadcaster = &p2pMock.MockBroadcaster{AttestationErr: errors.New("could not retrieve fork digest")}
+					s.AttestationsPool = attestations.NewPool()
+
+					request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(singleAtt))
+					writer := httptest.NewRecorder()
+					writer.Body = &bytes.Buffer{}
+
+					s.SubmitAttestations(writer, request)
+					resetCfg()
+					receiptID := writer.Header().Get(api.ReceiptIDHeader)
+					require.NotEqual(t, "", receiptID)
+					outcomes, ok := s.AttestationReceipts.Get(receiptID)
+					require.Equal(t, true, ok)
+					require.Equal(t, 1, len(outcomes))
+					if save {
+						assert.Equal(t, AttestationReceiptRetryPending, outcomes[0].Status)
+					} else {
+						assert.Equal(t, AttestationReceiptFailed, outcomes[0].Status)
+					}
+				}
+			})
+
+			t.Run("unknown", func(t *testing.T) {
+				request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+				request.SetPathValue("receipt_id", "foo")
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.GetAttestationReceipt(writer, request)
+				assert.Equal(t, http.StatusNotFound, writer.Code)
+			})
+		})
+		t.Run("multiple", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(multipleAtts)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+			assert.Equal(t, 2, broadcaster.NumAttestations())
+			assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		t.Run("broadcast failure", func(t *testing.T) {
+			s.Broadcaster = &p2pMock.MockBroadcaster{AttestationErr: errors.New("could not retrieve fork digest")}
+			s.AttestationsPool = attestations.NewPool()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(multipleAtts)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusInternalServerError, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "Attestations at index 0, 1 could not be broadcasted and were dropped", e.Message)
+			assert.Equal(t, 0, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		t.Run("broadcast failure saving failed attestations", func(t *testing.T) {
+			resetCfg := features.InitWithReset(&features.Flags{SaveBroadcastFailedAttestations: true})
+			defer resetCfg()
+			s.Broadcaster = &p2pMock.MockBroadcaster{AttestationErr: errors.New("could not retrieve fork digest")}
+			s.AttestationsPool = attestations.NewPool()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(multipleAtts)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusInternalServerError, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "Attestations at index 0, 1 could not be broadcasted and were saved to the pool", e.Message)
+			assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		t.Run("broadcast failure after invalid attestation", func(t *testing.T) {
+			s.Broadcaster = &p2pMock.MockBroadcaster{AttestationErr: errors.New("could not retrieve fork digest")}
+			s.AttestationsPool = attestations.NewPool()
+
+			body := strings.TrimSuffix(strings.TrimSpace(invalidAtt), "]") + "," + strings.TrimPrefix(strings.TrimSpace(singleAtt), "[")
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusInternalServerError, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "Attestations at index 1 could not be broadcasted", e.Message)
+		})
+		t.Run("duplicate", func(t *testing.T) {
+			s.Broadcaster = &p2pMock.MockBroadcaster{}
+			s.AttestationsPool = attestations.NewPool()
+
+			submit := func() *httptest.ResponseRecorder {
+				var body bytes.Buffer
+				_, err := body.WriteString(singleAtt)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestations(writer, request)
+				require.Equal(t, http.StatusOK, writer.Code)
+				return writer
+			}
+
+			// Newly pooled attestations are implied by the status code alone.
+			assert.Equal(t, 0, submit().Body.Len())
+			resp := &structs.SubmitAttestationsResponse{}
+			require.NoError(t, json.Unmarshal(submit().Body.Bytes(), resp))
+			require.Equal(t, 1, len(resp.Statuses))
+			assert.Equal(t, "0", resp.Statuses[0].Index)
+			assert.Equal(t, "duplicate", resp.Statuses[0].Status)
+			assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		t.Run("no body", func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.Equal(t, http.StatusBadRequest, e.Code)
+			assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+		})
+		t.Run("malformed JSON", func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("[{"))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusUnprocessableEntity, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.Equal(t, http.StatusUnprocessableEntity, e.Code)
+			assert.StringContains(t, "Unprocessable JSON", e.Message)
+		})
+		t.Run("empty", func(t *testing.T) {
+			var body bytes.Buffer
+			_, err := body.WriteString("[]")
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.Equal(t, http.StatusBadRequest, e.Code)
+			assert.Equal(t, true, strings.Contains(e.Message, "no data submitted"))
+		})
+		t.Run("invalid", func(t *testing.T) {
+			var body bytes.Buffer
+			_, err := body.WriteString(invalidAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &server.IndexedVerificationFailureError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.Equal(t, http.StatusBadRequest, e.Code)
+			require.Equal(t, 1, len(e.Failures))
+			assert.Equal(t, true, strings.Contains(e.Failures[0].Message, "Incorrect attestation signature"))
+		})
+		t.Run("too old", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+			currentSlot := params.BeaconConfig().SlotsPerEpoch * 3
+			chainService.Slot = &currentSlot
+			defer func() {
+				chainService.Slot = nil
+			}()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(singleAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &server.IndexedVerificationFailureError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			require.Equal(t, 1, len(e.Failures))
+			assert.StringContains(t, "Attestation is too old", e.Failures[0].Message)
+			assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+			assert.Equal(t, 0, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		// submitInvalidData submits a single attestation with the given data, expects it to be rejected
+		// and returns the failure message.
+		submitInvalidData := func(t *testing.T, data *ethpbv1alpha1.AttestationData) string {
+			s.Broadcaster = &p2pMock.MockBroadcaster{}
+			s.AttestationsPool = attestations.NewPool()
+			att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b11}, Data: data})
+			body, err := json.Marshal([]*structs.Attestation{structs.AttFromConsensus(att)})
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &server.IndexedVerificationFailureError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			require.Equal(t, 1, len(e.Failures))
+			assert.Equal(t, 0, s.AttestationsPool.UnaggregatedAttestationCount())
+			return e.Failures[0].Message
+		}
+		root := bytesutil.PadTo([]byte("root"), 32)
+
+		t.Run("zero roots", func(t *testing.T) {
+			t.Run("beacon block root", func(t *testing.T) {
+				msg := submitInvalidData(t, &ethpbv1alpha1.AttestationData{
+					Source: &ethpbv1alpha1.Checkpoint{Root: root},
+					Target: &ethpbv1alpha1.Checkpoint{Root: root},
+				})
+				assert.StringContains(t, "Zero attestation root: beacon block root is zero", msg)
+			})
+			t.Run("source root", func(t *testing.T) {
+				msg := submitInvalidData(t, &ethpbv1alpha1.AttestationData{
+					Slot:            params.BeaconConfig().SlotsPerEpoch,
+					BeaconBlockRoot: root,
+					Source:          &ethpbv1alpha1.Checkpoint{Epoch: 1},
+					Target:          &ethpbv1alpha1.Checkpoint{Epoch: 1, Root: root},
+				})
+				assert.StringContains(t, "Zero attestation root: source root is zero", msg)
+			})
+			t.Run("target root", func(t *testing.T) {
+				msg := submitInvalidData(t, &ethpbv1alpha1.AttestationData{
+					BeaconBlockRoot: root,
+					Source:          &ethpbv1alpha1.Checkpoint{Root: root},
+				})
+				assert.StringContains(t, "Zero attestation root: target root is zero", msg)
+			})
+		})
+		t.Run("inconsistent epochs", func(t *testing.T) {
+			t.Run("target epoch not matching slot", func(t *testing.T) {
+				msg := submitInvalidData(t, &ethpbv1alpha1.AttestationData{
+					BeaconBlockRoot: root,
+					Target:          &ethpbv1alpha1.Checkpoint{Epoch: 1, Root: root},
+				})
+				assert.StringContains(t, "Inconsistent attestation epochs: target epoch 1 does not match epoch 0 of slot 0", msg)
+			})
+			t.Run("source epoch after target epoch", func(t *testing.T) {
+				msg := submitInvalidData(t, &ethpbv1alpha1.AttestationData{
+					Slot:            params.BeaconConfig().SlotsPerEpoch,
+					BeaconBlockRoot: root,
+					Source:          &ethpbv1alpha1.Checkpoint{Epoch: 2, Root: root},
+					Target:          &ethpbv1alpha1.Checkpoint{Epoch: 1, Root: root},
+				})
+				assert.StringContains(t, "Inconsistent attestation epochs: source epoch 2 is greater than target epoch 1", msg)
+			})
+		})
+		t.Run("source verification", func(t *testing.T) {
+			sourceRoot, err := hexutil.Decode("0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2")
+			require.NoError(t, err)
+			s.VerifyAttestationSource = true
+			defer func() {
+				s.VerifyAttestationSource = false
+				s.FinalizationFetcher = nil
+			}()
+
+			submit := func(t *testing.T) *httptest.ResponseRecorder {
+				s.Broadcaster = &p2pMock.MockBroadcaster{}
+				s.AttestationsPool = attestations.NewPool()
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(singleAtt))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+				s.SubmitAttestations(writer, request)
+				return writer
+			}
+
+			t.Run("justified source", func(t *testing.T) {
+				s.FinalizationFetcher = &blockchainmock.ChainService{
+					CurrentJustifiedCheckPoint:  &ethpbv1alpha1.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("root"), 32)},
+					PreviousJustifiedCheckPoint: &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: sourceRoot},
+				}
+				writer := submit(t)
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+			t.Run("source mismatch", func(t *testing.T) {
+				s.FinalizationFetcher = &blockchainmock.ChainService{
+					CurrentJustifiedCheckPoint: &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("root"), 32)},
+				}
+				writer := submit(t)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &server.IndexedVerificationFailureError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				require.Equal(t, 1, len(e.Failures))
+				assert.StringContains(t, "Invalid attestation source: source checkpoint (epoch 0, root 0xcf8e", e.Failures[0].Message)
+				assert.Equal(t, 0, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+		})
+		t.Run("committee verification", func(t *testing.T) {
+			s.VerifyAttestationCommittee = true
+			defer func() {
+				s.VerifyAttestationCommittee = false
+			}()
+
+			t.Run("existing committee", func(t *testing.T) {
+				s.Broadcaster = &p2pMock.MockBroadcaster{}
+				s.AttestationsPool = attestations.NewPool()
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(singleAtt))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+				s.SubmitAttestations(writer, request)
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+			t.Run("committee index out of range", func(t *testing.T) {
+				msg := submitInvalidData(t, &ethpbv1alpha1.AttestationData{
+					CommitteeIndex:  1,
+					BeaconBlockRoot: root,
+					Source:          &ethpbv1alpha1.Checkpoint{Root: root},
+					Target:          &ethpbv1alpha1.Checkpoint{Root: root},
+				})
+				assert.StringContains(t, "Invalid attestation committee: committee index 1 is out of range: the committee count per slot at epoch 0 is 1", msg)
+			})
+		})
+		t.Run("only if slot", func(t *testing.T) {
+			submit := func(t *testing.T, query string) *httptest.ResponseRecorder {
+				s.Broadcaster = &p2pMock.MockBroadcaster{}
+				s.AttestationsPool = attestations.NewPool()
+				request := httptest.NewRequest(http.MethodPost, "http://example.com?"+query, strings.NewReader(singleAtt))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+				s.SubmitAttestations(writer, request)
+				return writer
+			}
+
+			t.Run("current slot", func(t *testing.T) {
+				writer := submit(t, "only_if_slot=0")
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+			t.Run("slot has passed", func(t *testing.T) {
+				writer := submit(t, "only_if_slot=5")
+				assert.Equal(t, http.StatusConflict, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.Equal(t, "Slot has passed: current slot 0 does not match slot 5", e.Message)
+				assert.Equal(t, 0, s.AttestationsPool.UnaggregatedAttestationCount())
+				assert.Equal(t, false, s.Broadcaster.(*p2pMock.MockBroadcaster).BroadcastCalled.Load())
+			})
+			t.Run("invalid", func(t *testing.T) {
+				writer := submit(t, "only_if_slot=foo")
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+			})
+		})
+		t.Run("stream results", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			stream := func(t *testing.T, body string) []*structs.AttestationSubmissionStatus {
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+				request.Header.Set("Accept", api.NdjsonMediaType)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestations(writer, request)
+				require.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, api.NdjsonMediaType, writer.Header().Get("Content-Type"))
+				assert.Equal(t, true, writer.Flushed)
+				var results []*structs.AttestationSubmissionStatus
+				dec := json.NewDecoder(writer.Body)
+				for dec.More() {
+					result := &structs.AttestationSubmissionStatus{}
+					require.NoError(t, dec.Decode(result))
+					results = append(results, result)
+				}
+				return results
+			}
+
+			t.Run("valid", func(t *testing.T) {
+				results := stream(t, multipleAtts)
+				require.Equal(t, 2, len(results))
+				assert.Equal(t, "0", results[0].Index)
+				assert.Equal(t, "new", results[0].Status)
+				assert.Equal(t, "1", results[1].Index)
+				assert.Equal(t, "new", results[1].Status)
+				assert.Equal(t, 2, broadcaster.NumAttestations())
+			})
+			t.Run("invalid", func(t *testing.T) {
+				results := stream(t, invalidAtt)
+				require.Equal(t, 1, len(results))
+				assert.Equal(t, "0", results[0].Index)
+				assert.Equal(t, "invalid", results[0].Status)
+				assert.StringContains(t, "Incorrect attestation signature", results[0].Message)
+			})
+		})
+		t.Run("publish targets", func(t *testing.T) {
+			peer1, peer2 := peer.ID("peer1"), peer.ID("peer2")
+			broadcaster := &p2pMock.MockBroadcaster{SubnetPeers: map[uint64][]peer.ID{0: {peer1, peer2}}}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(singleAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com?include_publish_targets=true", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.SubmitAttestationsPublishTargetsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			require.Equal(t, 1, len(resp.Data))
+			assert.Equal(t, "0", resp.Data[0].Index)
+			assert.Equal(t, "0", resp.Data[0].Subnet)
+			assert.DeepEqual(t, []string{peer1.String(), peer2.String()}, resp.Data[0].Peers)
+		})
+		t.Run("minimal verification level", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+			s.AttestationVerificationLevel = core.VerificationLevelMinimal
+			defer func() { s.AttestationVerificationLevel = "" }()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(invalidAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com?verification_level=minimal", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+			assert.Equal(t, 1, broadcaster.NumAttestations())
+		})
+		t.Run("strict verification level", func(t *testing.T) {
+			// Committees are computed from this test's state.
+			helpers.ClearCache()
+			data := &ethpbv1alpha1.AttestationData{
+				Slot:            0,
+				CommitteeIndex:  0,
+				BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot"), 32),
+				Source:          &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("sourceroot"), 32)},
+				Target:          &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("targetroot"), 32)},
+			}
+			sig, err := signing.ComputeDomainAndSign(bs, 0, data, params.BeaconConfig().DomainBeaconAttester, keys[0])
+			require.NoError(t, err)
+			att := &ethpbv1alpha1.Attestation{AggregationBits: b, Data: data, Signature: sig}
+
+			t.Run("valid signature", func(t *testing.T) {
+				broadcaster := &p2pMock.MockBroadcaster{}
+				s.Broadcaster = broadcaster
+				s.AttestationsPool = attestations.NewPool()
+
+				marshalled, err := json.Marshal([]*structs.Attestation{structs.AttFromConsensus(att)})
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com?verification_level=strict", bytes.NewReader(marshalled))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestations(writer, request)
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, 1, broadcaster.NumAttestations())
+			})
+			t.Run("signature over different data", func(t *testing.T) {
+				broadcaster := &p2pMock.MockBroadcaster{}
+				s.Broadcaster = broadcaster
+				s.AttestationsPool = attestations.NewPool()
+
+				var body bytes.Buffer
+				_, err := body.WriteString(singleAtt)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com?verification_level=strict", &body)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestations(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &server.IndexedVerificationFailureError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				require.Equal(t, 1, len(e.Failures))
+				assert.Equal(t, true, strings.Contains(e.Failures[0].Message, "Incorrect attestation signature"))
+				assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+			})
+			t.Run("configured on server", func(t *testing.T) {
+				broadcaster := &p2pMock.MockBroadcaster{}
+				s.Broadcaster = broadcaster
+				s.AttestationsPool = attestations.NewPool()
+				s.AttestationVerificationLevel = core.VerificationLevelStrict
+				defer func() { s.AttestationVerificationLevel = "" }()
+
+				var body bytes.Buffer
+				_, err := body.WriteString(singleAtt)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestations(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+			})
+		})
+		t.Run("verification level less strict than configured", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			var body bytes.Buffer
+			_, err := body.WriteString(invalidAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com?verification_level=minimal", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.Equal(t, true, strings.Contains(e.Message, "less strict than the level standard"))
+			assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+		})
+		t.Run("invalid verification level", func(t *testing.T) {
+			var body bytes.Buffer
+			_, err := body.WriteString(singleAtt)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com?verification_level=foo", &body)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestations(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.Equal(t, true, strings.Contains(e.Message, "Invalid verification level"))
+		})
+	})
+	t.Run("V2", func(t *testing.T) {
+		t.Run("pre-electra", func(t *testing.T) {
+			t.Run("single", func(t *testing.T) {
+				broadcaster := &p2pMock.MockBroadcaster{}
+				s.Broadcaster = broadcaster
+				s.AttestationsPool = attestations.NewPool()
+
+				var body bytes.Buffer
+				_, err := body.WriteString(singleAtt)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+				assert.Equal(t, 1, broadcaster.NumAttestations())
+				assert.Equal(t, "0x03", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetAggregationBits()))
+				assert.Equal(t, "0x8146f4397bfd8fd057ebbcd6a67327bdc7ed5fb650533edcb6377b650dea0b6da64c14ecd60846d5c0a0cd43893d6972092500f82c9d8a955e2b58c5ed3cbe885d84008ace6bd86ba9e23652f58e2ec207cec494c916063257abf285b9b15b15", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetSignature()))
+				assert.Equal(t, primitives.Slot(0), broadcaster.BroadcastAttestations[0].GetData().Slot)
+				assert.Equal(t, primitives.CommitteeIndex(0), broadcaster.BroadcastAttestations[0].GetData().CommitteeIndex)
+				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().BeaconBlockRoot))
+				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Source.Root))
+				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Source.Epoch)
+				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Target.Root))
+				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Target.Epoch)
+				assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+			t.Run("multiple", func(t *testing.T) {
+				broadcaster := &p2pMock.MockBroadcaster{}
+				s.Broadcaster = broadcaster
+				s.AttestationsPool = attestations.NewPool()
+
+				var body bytes.Buffer
+				_, err := body.WriteString(multipleAtts)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+				assert.Equal(t, 2, broadcaster.NumAttestations())
+				assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+			t.Run("no body", func(t *testing.T) {
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.Equal(t, http.StatusBadRequest, e.Code)
+				assert.Equal(t, true, strings.Contains(e.Message, "No data submitted: request body is empty"))
+			})
+			t.Run("empty", func(t *testing.T) {
+				var body bytes.Buffer
+				_, err := body.WriteString("[]")
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.Equal(t, http.StatusBadRequest, e.Code)
+				assert.Equal(t, true, strings.Contains(e.Message, "no data submitted: attestations array is empty"))
+			})
+			t.Run("invalid", func(t *testing.T) {
+				var body bytes.Buffer
+				_, err := body.WriteString(invalidAtt)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &server.IndexedVerificationFailureError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.Equal(t, http.StatusBadRequest, e.Code)
+				require.Equal(t, 1, len(e.Failures))
+				assert.Equal(t, true, strings.Contains(e.Failures[0].Message, "Incorrect attestation signature"))
+			})
+		})
+		t.Run("post-electra", func(t *testing.T) {
+			t.Run("single", func(t *testing.T) {
+				broadcaster := &p2pMock.MockBroadcaster{}
+				s.Broadcaster = broadcaster
+				s.AttestationsPool = attestations.NewPool()
+
+				var body bytes.Buffer
+				_, err := body.WriteString(singleAttElectra)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Electra))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+				assert.Equal(t, 1, broadcaster.NumAttestations())
+				assert.Equal(t, "0x03", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetAggregationBits()))
+				assert.Equal(t, "0x8146f4397bfd8fd057ebbcd6a67327bdc7ed5fb650533edcb6377b650dea0b6da64c14ecd60846d5c0a0cd43893d6972092500f82c9d8a955e2b58c5ed3cbe885d84008ace6bd86ba9e23652f58e2ec207cec494c916063257abf285b9b15b15", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetSignature()))
+				assert.Equal(t, primitives.Slot(0), broadcaster.BroadcastAttestations[0].GetData().Slot)
+				assert.Equal(t, primitives.CommitteeIndex(0), broadcaster.BroadcastAttestations[0].GetData().CommitteeIndex)
+				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().BeaconBlockRoot))
+				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Source.Root))
+				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Source.Epoch)
+				assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(broadcaster.BroadcastAttestations[0].GetData().Target.Root))
+				assert.Equal(t, primitives.Epoch(0), broadcaster.BroadcastAttestations[0].GetData().Target.Epoch)
+				assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+			t.Run("multiple", func(t *testing.T) {
+				broadcaster := &p2pMock.MockBroadcaster{}
+				s.Broadcaster = broadcaster
+				s.AttestationsPool = attestations.NewPool()
+
+				var body bytes.Buffer
+				_, err := body.WriteString(multipleAttsElectra)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Electra))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+				assert.Equal(t, 2, broadcaster.NumAttestations())
+				assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
+			})
+			t.Run("no body", func(t *testing.T) {
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+				request.Header.Set(api.VersionHeader, version.String(version.Electra))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.Equal(t, http.StatusBadRequest, e.Code)
+				assert.Equal(t, true, strings.Contains(e.Message, "No data submitted: request body is empty"))
+			})
+			t.Run("empty", func(t *testing.T) {
+				var body bytes.Buffer
+				_, err := body.WriteString("[]")
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Electra))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.Equal(t, http.StatusBadRequest, e.Code)
+				assert.Equal(t, true, strings.Contains(e.Message, "no data submitted: attestations array is empty"))
+			})
+			t.Run("invalid", func(t *testing.T) {
+				var body bytes.Buffer
+				_, err := body.WriteString(invalidAttElectra)
+				require.NoError(t, err)
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+				request.Header.Set(api.VersionHeader, version.String(version.Electra))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &server.IndexedVerificationFailureError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.Equal(t, http.StatusBadRequest, e.Code)
+				require.Equal(t, 1, len(e.Failures))
+				assert.Equal(t, true, strings.Contains(e.Failures[0].Message, "Incorrect attestation signature"))
+			})
+			t.Run("broadcast failure after invalid attestation", func(t *testing.T) {
+				s.Broadcaster = &p2pMock.MockBroadcaster{AttestationErr: errors.New("could not retrieve fork digest")}
+				s.AttestationsPool = attestations.NewPool()
+
+				body := strings.TrimSuffix(strings.TrimSpace(invalidAttElectra), "]") + "," + strings.TrimPrefix(strings.TrimSpace(singleAttElectra), "[")
+				request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+				request.Header.Set(api.VersionHeader, version.String(version.Electra))
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.SubmitAttestationsV2(writer, request)
+				assert.Equal(t, http.StatusInternalServerError, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.StringContains(t, "Attestations at index 1 could not be broadcasted", e.Message)
+			})
+		})
+	})
+	t.Run("protobuf", func(t *testing.T) {
+		submit := func(t *testing.T, v int, body []byte) *httptest.ResponseRecorder {
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+			request.Header.Set(api.VersionHeader, version.String(v))
+			request.Header.Set("Content-Type", api.ProtobufMediaType)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestationProtobuf(writer, request)
+			return writer
+		}
+
+		t.Run("pre-electra", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			var atts []*structs.Attestation
+			require.NoError(t, json.Unmarshal([]byte(singleAtt), &atts))
+			att, err := atts[0].ToConsensus()
+			require.NoError(t, err)
+			body, err := proto.Marshal(att)
+			require.NoError(t, err)
+
+			writer := submit(t, version.Phase0, body)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			require.Equal(t, 1, broadcaster.NumAttestations())
+			assert.DeepEqual(t, att, broadcaster.BroadcastAttestations[0])
+			assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		t.Run("post-electra", func(t *testing.T) {
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s.Broadcaster = broadcaster
+			s.AttestationsPool = attestations.NewPool()
+
+			var atts []*structs.AttestationElectra
+			require.NoError(t, json.Unmarshal([]byte(singleAttElectra), &atts))
+			att, err := atts[0].ToConsensus()
+			require.NoError(t, err)
+			body, err := proto.Marshal(att)
+			require.NoError(t, err)
+
+			writer := submit(t, version.Electra, body)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			require.Equal(t, 1, broadcaster.NumAttestations())
+			assert.DeepEqual(t, att, broadcaster.BroadcastAttestations[0])
+			assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+		})
+		t.Run("invalid", func(t *testing.T) {
+			var atts []*structs.Attestation
+			require.NoError(t, json.Unmarshal([]byte(invalidAtt), &atts))
+			att, err := atts[0].ToConsensus()
+			require.NoError(t, err)
+			body, err := proto.Marshal(att)
+			require.NoError(t, err)
+
+			writer := submit(t, version.Phase0, body)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &server.IndexedVerificationFailureError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			require.Equal(t, 1, len(e.Failures))
+			assert.StringContains(t, "Incorrect attestation signature", e.Failures[0].Message)
+		})
+		t.Run("missing data", func(t *testing.T) {
+			body, err := proto.Marshal(&ethpbv1alpha1.Attestation{Signature: make([]byte, 96)})
+			require.NoError(t, err)
+
+			writer := submit(t, version.Phase0, body)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "attestation data is incomplete", e.Message)
+		})
+		t.Run("malformed", func(t *testing.T) {
+			writer := submit(t, version.Phase0, []byte{0xff, 0xff})
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "Could not decode request body into consensus attestation", e.Message)
+		})
+		t.Run("no version", func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader([]byte{0x01}))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttestationProtobuf(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+		})
+	})
+
+}
+
+// failingAttestationBroadcaster fails the first broadcasts of attestations, up to the number of failures.
+type failingAttestationBroadcaster struct {
+	p2pMock.MockBroadcaster
+	failures int
+}
+
+func (b *failingAttestationBroadcaster) BroadcastAttestation(ctx context.Context, subnet uint64, att ethpbv1alpha1.Att) error {
+	if b.failures > 0 {
+		b.failures--
+		return errors.New("broadcast failed")
+	}
+	return b.MockBroadcaster.BroadcastAttestation(ctx, subnet, att)
+}
+
+func TestSubmitAttestations_PartialFailure(t *testing.T) {
+	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+		state.Validators = []*ethpbv1alpha1.Validator{{
+			PublicKey: make([]byte, fieldparams.BLSPubkeyLength),
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}}
+		return nil
+	})
+	require.NoError(t, err)
+	chainService := &blockchainmock.ChainService{State: bs, Genesis: time.Now()}
+	s := &Server{
+		HeadFetcher:        chainService,
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		OperationNotifier:  &blockchainmock.MockOperationNotifier{},
+		// Signatures of the submitted attestations are not checked.
+		AttestationVerificationLevel: core.VerificationLevelMinimal,
+	}
+	att := func(targetEpoch primitives.Epoch) *structs.Attestation {
+		root := bytesutil.PadTo([]byte("root"), 32)
+		return structs.AttFromConsensus(&ethpbv1alpha1.Attestation{
+			AggregationBits: bitfield.Bitlist{0b11},
+			Data: &ethpbv1alpha1.AttestationData{
+				BeaconBlockRoot: root,
+				Source:          &ethpbv1alpha1.Checkpoint{Root: root},
+				Target:          &ethpbv1alpha1.Checkpoint{Epoch: targetEpoch, Root: root},
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		})
+	}
+	submit := func(t *testing.T, atts ...*structs.Attestation) *httptest.ResponseRecorder {
+		s.Broadcaster = &p2pMock.MockBroadcaster{}
+		s.AttestationsPool = attestations.NewPool()
+		body, err := json.Marshal(atts)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttestations(writer, request)
+		return writer
+	}
+
+	t.Run("mixed", func(t *testing.T) {
+		writer := submit(t, att(1), att(0))
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		resp := &structs.SubmitAttestationsPartialResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "Some attestations failed validation", resp.Message)
+		assert.Equal(t, "1", resp.Accepted)
+		require.Equal(t, 1, len(resp.Statuses))
+		assert.Equal(t, "1", resp.Statuses[0].Index)
+		require.Equal(t, 1, len(resp.Failures))
+		assert.Equal(t, 0, resp.Failures[0].Index)
+		assert.StringContains(t, "Inconsistent attestation epochs", resp.Failures[0].Message)
+		assert.Equal(t, 1, s.Broadcaster.(*p2pMock.MockBroadcaster).NumAttestations())
+		assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+	})
+	t.Run("mixed with broadcast failure", func(t *testing.T) {
+		broadcaster := &failingAttestationBroadcaster{failures: 1}
+		s.Broadcaster = broadcaster
+		s.AttestationsPool = attestations.NewPool()
+		body, err := json.Marshal([]*structs.Attestation{att(0), att(1), att(0)})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttestations(writer, request)
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		resp := &structs.SubmitAttestationsPartialResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.StringContains(t, "Attestations at index 0 could not be broadcasted", resp.Message)
+		assert.Equal(t, "1", resp.Accepted)
+		assert.DeepEqual(t, []string{"0"}, resp.FailedBroadcasts)
+		require.Equal(t, 1, len(resp.Failures))
+		assert.Equal(t, 1, resp.Failures[0].Index)
+		require.Equal(t, 1, len(resp.Statuses))
+		assert.Equal(t, "2", resp.Statuses[0].Index)
+		assert.Equal(t, 1, broadcaster.NumAttestations())
+	})
+	t.Run("mixed with deferred broadcast", func(t *testing.T) {
+		// The mock peers provider is connected to two peers.
+		s.PeersFetcher = &p2pMock.MockPeersProvider{}
+		s.MinAttestationBroadcastPeers = 3
+		defer func() {
+			s.PeersFetcher = nil
+			s.MinAttestationBroadcastPeers = 0
+		}()
+
+		writer := submit(t, att(1), att(0))
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		resp := &structs.SubmitAttestationsPartialResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.BroadcastDeferred)
+		// Attestations whose broadcast is deferred are accepted once they are pooled.
+		assert.Equal(t, "1", resp.Accepted)
+		require.Equal(t, 1, len(resp.Statuses))
+		assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+	})
+	t.Run("mixed V2", func(t *testing.T) {
+		s.Broadcaster = &p2pMock.MockBroadcaster{}
+		s.AttestationsPool = attestations.NewPool()
+		body, err := json.Marshal([]*structs.Attestation{att(1), att(0)})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+		request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttestationsV2(writer, request)
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		resp := &structs.SubmitAttestationsPartialResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "1", resp.Accepted)
+		require.Equal(t, 1, len(resp.Failures))
+		assert.Equal(t, 0, resp.Failures[0].Index)
+	})
+	t.Run("all failed", func(t *testing.T) {
+		writer := submit(t, att(1), att(2))
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &server.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, 2, len(e.Failures))
+		assert.Equal(t, false, s.Broadcaster.(*p2pMock.MockBroadcaster).BroadcastCalled.Load())
+	})
+	t.Run("all succeeded", func(t *testing.T) {
+		writer := submit(t, att(0))
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, 0, writer.Body.Len())
+		assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+	})
+	t.Run("all succeeded with duplicate", func(t *testing.T) {
+		writer := submit(t, att(0), att(0))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SubmitAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Statuses))
+		assert.Equal(t, "new", resp.Statuses[0].Status)
+		assert.Equal(t, "duplicate", resp.Statuses[1].Status)
+	})
+}
+
+func TestSubmitAttestations_PublishTargets(t *testing.T) {
+	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+		state.Validators = []*ethpbv1alpha1.Validator{{
+			PublicKey: make([]byte, fieldparams.BLSPubkeyLength),
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}}
+		return nil
+	})
+	require.NoError(t, err)
+	chainService := &blockchainmock.ChainService{State: bs, Genesis: time.Now()}
+	s := &Server{
+		HeadFetcher:        chainService,
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		OperationNotifier:  &blockchainmock.MockOperationNotifier{},
+		// Signatures of the submitted attestations are not checked.
+		AttestationVerificationLevel: core.VerificationLevelMinimal,
+	}
+	root := bytesutil.PadTo([]byte("root"), 32)
+	body, err := json.Marshal([]*structs.Attestation{structs.AttFromConsensus(&ethpbv1alpha1.Attestation{
+		AggregationBits: bitfield.Bitlist{0b11},
+		Data: &ethpbv1alpha1.AttestationData{
+			BeaconBlockRoot: root,
+			Source:          &ethpbv1alpha1.Checkpoint{Root: root},
+			Target:          &ethpbv1alpha1.Checkpoint{Root: root},
+		},
+		Signature: make([]byte, fieldparams.BLSSignatureLength),
+	})})
+	require.NoError(t, err)
+
+	t.Run("unsupported broadcaster", func(t *testing.T) {
+		broadcaster := &p2pMock.MockBroadcaster{}
+		// Only the methods of p2p.Broadcaster are promoted, so the subnet peers cannot be listed.
+		s.Broadcaster = struct{ p2p.Broadcaster }{broadcaster}
+		s.AttestationsPool = attestations.NewPool()
+		request := httptest.NewRequest(http.MethodPost, "http://example.com?include_publish_targets=true", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttestations(writer, request)
+		assert.Equal(t, http.StatusNotImplemented, writer.Code)
+		assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+		assert.Equal(t, 0, s.AttestationsPool.UnaggregatedAttestationCount())
+	})
+	t.Run("peer lookup fails", func(t *testing.T) {
+		s.Broadcaster = &p2pMock.MockBroadcaster{SubnetPeersErr: errors.New("peers unavailable")}
+		s.AttestationsPool = attestations.NewPool()
+		request := httptest.NewRequest(http.MethodPost, "http://example.com?include_publish_targets=true", bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttestations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SubmitAttestationsPublishTargetsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, 0, len(resp.Data[0].Peers))
+		assert.StringContains(t, "peers unavailable", resp.Data[0].Error)
+		require.Equal(t, 1, len(resp.Statuses))
+		assert.Equal(t, "new", resp.Statuses[0].Status)
+		assert.Equal(t, 1, s.AttestationsPool.UnaggregatedAttestationCount())
+	})
+}
+
+func TestSubmitAttestationsAndAggregate(t *testing.T) {
+	transition.SkipSlotCache.Disable()
+	defer transition.SkipSlotCache.Enable()
+
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	// Required for correct committee size calculation.
+	c.SlotsPerEpoch = 1
+	params.OverrideBeaconConfig(c)
+
+	validators := make([]*ethpbv1alpha1.Validator, 4)
+	for i := range validators {
+		validators[i] = &ethpbv1alpha1.Validator{
+			PublicKey: bytesutil.PadTo([]byte{byte(i)}, 48),
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+		state.Validators = validators
+		state.Slot = 1
+		state.PreviousJustifiedCheckpoint = &ethpbv1alpha1.Checkpoint{
+			Epoch: 0,
+			Root:  bytesutil.PadTo([]byte("sourceroot1"), 32),
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	chainService := &blockchainmock.ChainService{State: bs, Genesis: time.Now()}
+	s := &Server{
+		HeadFetcher:        chainService,
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		OperationNotifier:  &blockchainmock.MockOperationNotifier{},
+	}
+
+	newAtt := func(t *testing.T, bit uint64, targetRoot string) *ethpbv1alpha1.Attestation {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		bits := bitfield.NewBitlist(4)
+		bits.SetBitAt(bit, true)
+		return &ethpbv1alpha1.Attestation{
+			AggregationBits: bits,
+			Data: &ethpbv1alpha1.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot"), 32),
+				Source:          &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("sourceroot1"), 32)},
+				Target:          &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte(targetRoot), 32)},
+			},
+			Signature: key.Sign([]byte("message")).Marshal(),
+		}
+	}
+	submit := func(t *testing.T, atts []*ethpbv1alpha1.Attestation) *httptest.ResponseRecorder {
+		b, err := json.Marshal(structs.AttsFromConsensus(atts))
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(b))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SubmitAttestationsAndAggregate(writer, request)
+		return writer
+	}
+
+	t.Run("merged", func(t *testing.T) {
+		s.Broadcaster = &p2pMock.MockBroadcaster{}
+		s.AttestationsPool = attestations.NewPool()
+
+		atts := []*ethpbv1alpha1.Attestation{
+			newAtt(t, 0, "targetroot1"),
+			newAtt(t, 1, "targetroot2"),
+			newAtt(t, 2, "targetroot1"),
+			// Overlaps with the first attestation, so it is left out of the aggregate.
+			newAtt(t, 0, "targetroot1"),
+		}
+		writer := submit(t, atts)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SubmitAttestationsAndAggregateResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 4, len(resp.Statuses))
+		require.Equal(t, 1, len(resp.Aggregates))
+		assert.DeepEqual(t, []string{"0", "2"}, resp.Aggregates[0].Indices)
+
+		sszBytes, err := hexutil.Decode(resp.Aggregates[0].Ssz)
+		require.NoError(t, err)
+		aggregate := &ethpbv1alpha1.Attestation{}
+		require.NoError(t, aggregate.UnmarshalSSZ(sszBytes))
+		assert.Equal(t, uint64(2), aggregate.AggregationBits.Count())
+		assert.Equal(t, true, aggregate.AggregationBits.BitAt(0))
+		assert.Equal(t, true, aggregate.AggregationBits.BitAt(2))
+		assert.DeepEqual(t, atts[0].Data, aggregate.Data)
+		sig0, err := bls.SignatureFromBytes(atts[0].Signature)
+		require.NoError(t, err)
+		sig2, err := bls.SignatureFromBytes(atts[2].Signature)
+		require.NoError(t, err)
+		assert.DeepEqual(t, bls.AggregateSignatures([]common.Signature{sig0, sig2}).Marshal(), aggregate.Signature)
+		assert.Equal(t, 1, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("nothing to merge", func(t *testing.T) {
+		s.Broadcaster = &p2pMock.MockBroadcaster{}
+		s.AttestationsPool = attestations.NewPool()
+
+		writer := submit(t, []*ethpbv1alpha1.Attestation{newAtt(t, 0, "targetroot1"), newAtt(t, 1, "targetroot2")})
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SubmitAttestationsAndAggregateResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 2, len(resp.Statuses))
+		assert.Equal(t, 0, len(resp.Aggregates))
+		assert.Equal(t, 0, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("invalid attestation", func(t *testing.T) {
+		s.Broadcaster = &p2pMock.MockBroadcaster{}
+		s.AttestationsPool = attestations.NewPool()
+
+		invalid := newAtt(t, 1, "targetroot1")
+		invalid.Signature = make([]byte, 96)
+		writer := submit(t, []*ethpbv1alpha1.Attestation{newAtt(t, 0, "targetroot1"), invalid, newAtt(t, 2, "targetroot1")})
+		require.Equal(t, http.StatusAccepted, writer.Code)
+		resp := &structs.SubmitAttestationsPartialResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.Accepted)
+		require.Equal(t, 1, len(resp.Failures))
+		assert.Equal(t, 1, resp.Failures[0].Index)
+		// The valid attestations are still merged.
+		require.Equal(t, 1, len(resp.Aggregates))
+		assert.DeepEqual(t, []string{"0", "2"}, resp.Aggregates[0].Indices)
+		assert.Equal(t, 1, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("every attestation invalid", func(t *testing.T) {
+		s.Broadcaster = &p2pMock.MockBroadcaster{}
+		s.AttestationsPool = attestations.NewPool()
+
+		invalid := newAtt(t, 1, "targetroot1")
+		invalid.Signature = make([]byte, 96)
+		writer := submit(t, []*ethpbv1alpha1.Attestation{invalid})
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &server.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 1, len(e.Failures))
+		assert.Equal(t, 0, e.Failures[0].Index)
+		assert.Equal(t, 0, s.AttestationsPool.AggregatedAttestationCount())
+	})
+}
+
+func TestVerifyAttestationInclusionWindow(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	c.DenebForkEpoch = 10
+	params.OverrideBeaconConfig(c)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	denebSlot := slotsPerEpoch * 10
+
+	t.Run("pre-deneb within window", func(t *testing.T) {
+		require.NoError(t, verifyAttestationInclusionWindow(1, 1+slotsPerEpoch))
+	})
+	t.Run("pre-deneb too old", func(t *testing.T) {
+		require.ErrorContains(t, "more than", verifyAttestationInclusionWindow(1, 2+slotsPerEpoch))
+	})
+	t.Run("deneb previous epoch", func(t *testing.T) {
+		require.NoError(t, verifyAttestationInclusionWindow(denebSlot, denebSlot+2*slotsPerEpoch-1))
+	})
+	t.Run("deneb too old", func(t *testing.T) {
+		require.ErrorContains(t, "older than the previous epoch", verifyAttestationInclusionWindow(denebSlot, denebSlot+2*slotsPerEpoch))
+	})
+}
+
+func TestVerifyAttestationCommittee(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, bs.SetValidators([]*ethpbv1alpha1.Validator{{
+		PublicKey:         make([]byte, fieldparams.BLSPubkeyLength),
+		ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+	}}))
+	s := &Server{
+		ChainInfoFetcher:           &blockchainmock.ChainService{State: bs},
+		VerifyAttestationCommittee: true,
+	}
+	electraAtt := func(committees ...uint64) *ethpbv1alpha1.AttestationElectra {
+		committeeBits := primitives.NewAttestationCommitteeBits()
+		for _, c := range committees {
+			committeeBits.SetBitAt(c, true)
+		}
+		return util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{CommitteeBits: committeeBits})
+	}
+
+	t.Run("existing committee", func(t *testing.T) {
+		att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{})
+		require.NoError(t, s.verifyAttestationCommittee(context.Background(), att, make(map[primitives.Epoch]uint64)))
+	})
+	t.Run("committee index out of range", func(t *testing.T) {
+		att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{Data: &ethpbv1alpha1.AttestationData{CommitteeIndex: 1}})
+		err := s.verifyAttestationCommittee(context.Background(), att, make(map[primitives.Epoch]uint64))
+		require.ErrorContains(t, "committee index 1 is out of range: the committee count per slot at epoch 0 is 1", err)
+	})
+	t.Run("cached committee count", func(t *testing.T) {
+		att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{Data: &ethpbv1alpha1.AttestationData{CommitteeIndex: 1}})
+		require.NoError(t, s.verifyAttestationCommittee(context.Background(), att, map[primitives.Epoch]uint64{0: 2}))
+	})
+	t.Run("electra existing committee", func(t *testing.T) {
+		require.NoError(t, s.verifyAttestationCommittee(context.Background(), electraAtt(0), make(map[primitives.Epoch]uint64)))
+	})
+	t.Run("electra committee bit out of range", func(t *testing.T) {
+		err := s.verifyAttestationCommittee(context.Background(), electraAtt(0, 3), make(map[primitives.Epoch]uint64))
+		require.ErrorContains(t, "committee index 3 is out of range", err)
+	})
+	t.Run("electra no committee bit", func(t *testing.T) {
+		err := s.verifyAttestationCommittee(context.Background(), electraAtt(), make(map[primitives.Epoch]uint64))
+		require.ErrorContains(t, "no committee bit is set", err)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{Data: &ethpbv1alpha1.AttestationData{CommitteeIndex: 1}})
+		disabled := &Server{}
+		require.NoError(t, disabled.verifyAttestationCommittee(context.Background(), att, make(map[primitives.Epoch]uint64)))
+	})
+}
+
+func TestVerifyAttestationRoots(t *testing.T) {
+	root := bytesutil.PadTo([]byte("root"), 32)
+	zero := make([]byte, 32)
+	data := func(blockRoot []byte, sourceEpoch primitives.Epoch, sourceRoot, targetRoot []byte) *ethpbv1alpha1.AttestationData {
+		return &ethpbv1alpha1.AttestationData{
+			BeaconBlockRoot: blockRoot,
+			Source:          &ethpbv1alpha1.Checkpoint{Epoch: sourceEpoch, Root: sourceRoot},
+			Target:          &ethpbv1alpha1.Checkpoint{Epoch: 2, Root: targetRoot},
+		}
+	}
+
+	require.NoError(t, verifyAttestationRoots(data(root, 1, root, root)))
+	require.NoError(t, verifyAttestationRoots(data(root, 0, zero, root)), "genesis source root should be allowed")
+	require.ErrorContains(t, "beacon block root is zero", verifyAttestationRoots(data(zero, 1, root, root)))
+	require.ErrorContains(t, "source root is zero", verifyAttestationRoots(data(root, 1, zero, root)))
+	require.ErrorContains(t, "target root is zero", verifyAttestationRoots(data(root, 1, root, zero)))
+}
+
+func TestVerifyAttestationEpochs(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	data := func(slot primitives.Slot, sourceEpoch, targetEpoch primitives.Epoch) *ethpbv1alpha1.AttestationData {
+		return &ethpbv1alpha1.AttestationData{
+			Slot:   slot,
+			Source: &ethpbv1alpha1.Checkpoint{Epoch: sourceEpoch},
+			Target: &ethpbv1alpha1.Checkpoint{Epoch: targetEpoch},
+		}
+	}
+
+	require.NoError(t, verifyAttestationEpochs(data(slotsPerEpoch*2, 1, 2)))
+	require.NoError(t, verifyAttestationEpochs(data(slotsPerEpoch*2+1, 2, 2)), "source may equal target")
+	require.ErrorContains(t, "target epoch 1 does not match epoch 2", verifyAttestationEpochs(data(slotsPerEpoch*2, 1, 1)))
+	require.ErrorContains(t, "target epoch 3 does not match epoch 2", verifyAttestationEpochs(data(slotsPerEpoch*3-1, 1, 3)))
+	require.ErrorContains(t, "source epoch 3 is greater than target epoch 2", verifyAttestationEpochs(data(slotsPerEpoch*2, 3, 2)))
+}
+
+func TestValidateAttestations(t *testing.T) {
+	s := &Server{GenesisTimeFetcher: &blockchainmock.ChainService{Genesis: time.Now()}}
+
+	validate := func(t *testing.T, body string, version string) *structs.ValidateAttestationsResponse {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+		if version != "" {
+			request.Header.Set(api.VersionHeader, version)
+		}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ValidateAttestations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ValidateAttestationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
+	t.Run("valid and invalid", func(t *testing.T) {
+		s.AttestationsPool = attestations.NewPool()
+		s.Broadcaster = &p2pMock.MockBroadcaster{}
+		// Join the entries of both fixtures into a single request.
+		body := strings.TrimSuffix(strings.TrimSpace(singleAtt), "]") + "," + strings.TrimPrefix(strings.TrimSpace(invalidAtt), "[")
+
+		resp := validate(t, body, "")
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "0", resp.Data[0].Index)
+		assert.Equal(t, true, resp.Data[0].Valid)
+		assert.Equal(t, "1", resp.Data[1].Index)
+		assert.Equal(t, false, resp.Data[1].Valid)
+		assert.StringContains(t, "Incorrect attestation signature", resp.Data[1].Message)
+		// Validation has no side effects.
+		assert.Equal(t, 0, s.AttestationsPool.UnaggregatedAttestationCount())
+		assert.Equal(t, false, s.Broadcaster.(*p2pMock.MockBroadcaster).BroadcastCalled.Load())
+	})
+	t.Run("electra", func(t *testing.T) {
+		resp := validate(t, singleAttElectra, version.String(version.Electra))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, true, resp.Data[0].Valid)
+	})
+	t.Run("empty", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("[]"))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ValidateAttestations(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
+}
+
+func TestSubmitAggregateAndProofs(t *testing.T) {
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+	ctx := context.Background()
+	bs, keys := util.DeterministicGenesisState(t, 128)
+	committee, err := helpers.BeaconCommitteeFromState(ctx, bs, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 2)
+	outsider := primitives.ValidatorIndex(0)
+	for slices.Contains(committee, outsider) {
+		outsider++
+	}
+
+	data := &ethpbv1alpha1.AttestationData{
+		Slot:            1,
+		CommitteeIndex:  0,
+		BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot"), 32),
+		Source:          &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("sourceroot"), 32)},
+		Target:          &ethpbv1alpha1.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("targetroot"), 32)},
+	}
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(1, true)
+	sigs := make([]common.Signature, 0, 2)
+	for _, idx := range committee[:2] {
+		sb, err := signing.ComputeDomainAndSign(bs, 0, data, params.BeaconConfig().DomainBeaconAttester, keys[idx])
+		require.NoError(t, err)
+		sig, err := bls.SignatureFromBytes(sb)
+		require.NoError(t, err)
+		sigs = append(sigs, sig)
+	}
+	aggregate := &ethpbv1alpha1.Attestation{AggregationBits: bits, Data: data, Signature: bls.AggregateSignatures(sigs).Marshal()}
+
+	// signedAggregate builds the aggregate and proof of the aggregator, with the selection proof signed by the selector
+	// over the given slot and the aggregate and proof signed by the signer.
+	signedAggregate := func(t *testing.T, aggregator, selector, signer primitives.ValidatorIndex, slot primitives.Slot) *structs.SignedAggregateAttestationAndProof {
+		slotRoot := primitives.SSZUint64(slot)
+		proof, err := signing.ComputeDomainAndSign(bs, 0, &slotRoot, params.BeaconConfig().DomainSelectionProof, keys[selector])
+		require.NoError(t, err)
+		msg := &ethpbv1alpha1.AggregateAttestationAndProof{AggregatorIndex: aggregator, Aggregate: aggregate, SelectionProof: proof}
+		sig, err := signing.ComputeDomainAndSign(bs, 0, msg, params.BeaconConfig().DomainAggregateAndProof, keys[signer])
+		require.NoError(t, err)
+		return &structs.SignedAggregateAttestationAndProof{
+			Message: &structs.AggregateAttestationAndProof{
+				AggregatorIndex: fmt.Sprintf("%d", aggregator),
+				Aggregate:       structs.AttFromConsensus(aggregate),
+				SelectionProof:  hexutil.Encode(proof),
+			},
+			Signature: hexutil.Encode(sig),
+		}
+	}
+
+	submit := func(t *testing.T, items ...*structs.SignedAggregateAttestationAndProof) (*Server, *httptest.ResponseRecorder) {
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			GenesisTimeFetcher: &blockchainmock.ChainService{Genesis: time.Now()},
+			OperationNotifier:  &blockchainmock.MockOperationNotifier{},
+			AttestationsPool:   attestations.NewPool(),
+		}
+		marshalled, err := json.Marshal(items)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(marshalled))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAggregateAndProofs(writer, request)
+		return s, writer
+	}
+	requireFailure := func(t *testing.T, writer *httptest.ResponseRecorder, message string) {
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &server.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 1, len(e.Failures))
+		assert.StringContains(t, message, e.Failures[0].Message)
+	}
+
+	aggregator := committee[0]
+	t.Run("ok", func(t *testing.T) {
+		s, writer := submit(t, signedAggregate(t, aggregator, aggregator, aggregator, 1))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SubmitAggregateAndProofsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Statuses))
+		assert.Equal(t, "new", resp.Statuses[0].Status)
+		assert.Equal(t, 1, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("aggregator not in committee", func(t *testing.T) {
+		s, writer := submit(t, signedAggregate(t, outsider, outsider, outsider, 1))
+		requireFailure(t, writer, "is not a member of committee")
+		assert.Equal(t, 0, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("selection proof over wrong slot", func(t *testing.T) {
+		s, writer := submit(t, signedAggregate(t, aggregator, aggregator, aggregator, 2))
+		requireFailure(t, writer, "could not verify selection proof")
+		assert.Equal(t, 0, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("selection proof by another validator", func(t *testing.T) {
+		s, writer := submit(t, signedAggregate(t, aggregator, committee[1], aggregator, 1))
+		requireFailure(t, writer, "could not verify selection proof")
+		assert.Equal(t, 0, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("aggregate and proof signed by another validator", func(t *testing.T) {
+		s, writer := submit(t, signedAggregate(t, aggregator, aggregator, committee[1], 1))
+		requireFailure(t, writer, "could not verify aggregate and proof signature")
+		assert.Equal(t, 0, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("some invalid", func(t *testing.T) {
+		s, writer := submit(t, signedAggregate(t, outsider, outsider, outsider, 1), signedAggregate(t, aggregator, aggregator, aggregator, 1))
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		resp := &structs.SubmitAggregateAndProofsFailureResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Failures))
+		assert.Equal(t, 0, resp.Failures[0].Index)
+		require.Equal(t, 1, len(resp.Statuses))
+		assert.Equal(t, "1", resp.Statuses[0].Index)
+		assert.Equal(t, "new", resp.Statuses[0].Status)
+		assert.Equal(t, 1, s.AttestationsPool.AggregatedAttestationCount())
+	})
+	t.Run("duplicate", func(t *testing.T) {
+		notifier := &blockchainmock.MockOperationNotifier{}
+		opChannel := make(chan *feed.Event, 2)
+		opSub := notifier.OperationFeed().Subscribe(opChannel)
+		defer opSub.Unsubscribe()
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			GenesisTimeFetcher: &blockchainmock.ChainService{Genesis: time.Now()},
+			OperationNotifier:  notifier,
+			AttestationsPool:   attestations.NewPool(),
+		}
+		item := signedAggregate(t, aggregator, aggregator, aggregator, 1)
+		marshalled, err := json.Marshal([]*structs.SignedAggregateAttestationAndProof{item, item})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(marshalled))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAggregateAndProofs(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SubmitAggregateAndProofsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Statuses))
+		assert.Equal(t, "new", resp.Statuses[0].Status)
+		assert.Equal(t, "duplicate", resp.Statuses[1].Status)
+		assert.Equal(t, 1, len(opChannel))
+	})
+	t.Run("empty", func(t *testing.T) {
+		_, writer := submit(t)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
+}
+
+func TestListVoluntaryExits(t *testing.T) {
+	exit1 := &ethpbv1alpha1.SignedVoluntaryExit{
+		Exit: &ethpbv1alpha1.VoluntaryExit{
+			Epoch:          1,
+			ValidatorIndex: 1,
+		},
+		Signature: bytesutil.PadTo([]byte("signature1"), 96),
+	}
+	exit2 := &ethpbv1alpha1.SignedVoluntaryExit{
+		Exit: &ethpbv1alpha1.VoluntaryExit{
+			Epoch:          2,
+			ValidatorIndex: 2,
+		},
+		Signature: bytesutil.PadTo([]byte("signature2"), 96),
+	}
+
+	s := &Server{
+		VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2}},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.ListVoluntaryExits(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, "2", writer.Header().Get(api.PoolSizeHeader))
+	resp := &structs.ListVoluntaryExitsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp)
+	require.NotNil(t, resp.Data)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, "0x7369676e6174757265310000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", resp.Data[0].Signature)
+	assert.Equal(t, "1", resp.Data[0].Message.Epoch)
+	assert.Equal(t, "1", resp.Data[0].Message.ValidatorIndex)
+	assert.Equal(t, "0x7369676e6174757265320000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", resp.Data[1].Signature)
+	assert.Equal(t, "2", resp.Data[1].Message.Epoch)
+	assert.Equal(t, "2", resp.Data[1].Message.ValidatorIndex)
+
+	t.Run("include ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?include_ssz=true", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListVoluntaryExits(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListVoluntaryExitsWithSSZResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].Message.ValidatorIndex)
+		sszBytes, err := base64.StdEncoding.DecodeString(resp.Data[1].SSZ)
+		require.NoError(t, err)
+		expected, err := exit2.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, sszBytes)
+	})
+	t.Run("validator index", func(t *testing.T) {
+		exit3 := &ethpbv1alpha1.SignedVoluntaryExit{
+			Exit: &ethpbv1alpha1.VoluntaryExit{
+				Epoch:          3,
+				ValidatorIndex: 3,
+			},
+			Signature: bytesutil.PadTo([]byte("signature3"), 96),
+		}
+		s := &Server{
+			VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2, exit3}},
+		}
+
+		for _, query := range []string{"validator_index=1&validator_index=3", "validator_index=1,3"} {
+			request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.ListVoluntaryExits(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, "3", writer.Header().Get(api.PoolSizeHeader))
+			resp := &structs.ListVoluntaryExitsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			require.Equal(t, 2, len(resp.Data))
+			assert.Equal(t, "1", resp.Data[0].Message.ValidatorIndex)
+			assert.Equal(t, "3", resp.Data[1].Message.ValidatorIndex)
+		}
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?validator_index=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListVoluntaryExits(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+	})
+}
+
+func TestListVoluntaryExits_RecoversFromPanic(t *testing.T) {
+	// A nil pool makes the handler panic.
+	s := &Server{}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.ListVoluntaryExits(writer, request)
+	assert.Equal(t, http.StatusInternalServerError, writer.Code)
+	e := &httputil.DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.Equal(t, http.StatusInternalServerError, e.Code)
+	assert.Equal(t, "Internal server error", e.Message)
+}
+
+func TestSubmitVoluntaryExit(t *testing.T) {
+	transition.SkipSlotCache.Disable()
+	defer transition.SkipSlotCache.Enable()
+
+	t.Run("ok", func(t *testing.T) {
+		_, keys, err := util.DeterministicDepositsAndKeys(1)
+		require.NoError(t, err)
+		validator := &ethpbv1alpha1.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+			PublicKey: keys[0].PublicKey().Marshal(),
+		}
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = []*ethpbv1alpha1.Validator{validator}
+			// Satisfy activity time required before exiting.
+			state.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))
+			return nil
+		})
+		require.NoError(t, err)
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			VoluntaryExitsPool: &mock.PoolMock{},
+			Broadcaster:        broadcaster,
+		}
+
+		var body bytes.Buffer
+		_, err = body.WriteString(exit1)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		require.NoError(t, err)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(pendingExits))
+		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+	})
+	t.Run("ssz", func(t *testing.T) {
+		_, keys, err := util.DeterministicDepositsAndKeys(1)
+		require.NoError(t, err)
+		validator := &ethpbv1alpha1.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+			PublicKey: keys[0].PublicKey().Marshal(),
+		}
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = []*ethpbv1alpha1.Validator{validator}
+			// Satisfy activity time required before exiting.
+			state.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))
+			return nil
+		})
+		require.NoError(t, err)
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			VoluntaryExitsPool: &mock.PoolMock{},
+			Broadcaster:        broadcaster,
+		}
+
+		var req structs.SignedVoluntaryExit
+		require.NoError(t, json.Unmarshal([]byte(exit1), &req))
+		exit, err := req.ToConsensus()
+		require.NoError(t, err)
+		sszBytes, err := exit.MarshalSSZ()
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(sszBytes))
+		request.Header.Set("Content-Type", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(pendingExits))
+		assert.DeepEqual(t, exit, pendingExits[0])
+		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+	})
+	t.Run("broadcast disabled", func(t *testing.T) {
+		_, keys, err := util.DeterministicDepositsAndKeys(1)
+		require.NoError(t, err)
+		validator := &ethpbv1alpha1.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+			PublicKey: keys[0].PublicKey().Marshal(),
+		}
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = []*ethpbv1alpha1.Validator{validator}
+			// Satisfy activity time required before exiting.
+			state.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))
+			return nil
+		})
+		require.NoError(t, err)
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			VoluntaryExitsPool: voluntaryexits.NewPool(),
+			Broadcaster:        broadcaster,
+		}
+
+		request := httptest.NewRequest(http.MethodPost, "http://example.com?broadcast=false", strings.NewReader(exit1))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(pendingExits))
+		assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+		// Staged exits are not included in blocks proposed by the node.
+		inclusion, err := s.VoluntaryExitsPool.ExitsForInclusion(bs, bs.Slot())
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(inclusion))
+
+		// Submitting the exit again releases it.
+		request = httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(exit1))
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+		inclusion, err = s.VoluntaryExitsPool.ExitsForInclusion(bs, bs.Slot())
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(inclusion))
+	})
+	t.Run("invalid broadcast", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com?broadcast=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{}
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "broadcast is invalid", e.Message)
+	})
+	t.Run("invalid ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader([]byte{0x01, 0x02}))
+		request.Header.Set("Content-Type", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{}
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Could not decode request body into consensus exit", e.Message)
+	})
+	t.Run("across fork", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		config := params.BeaconConfig()
+		config.AltairForkEpoch = params.BeaconConfig().ShardCommitteePeriod + 1
+		params.OverrideBeaconConfig(config)
+
+		bs, _ := util.DeterministicGenesisState(t, 1)
+		// Satisfy activity time required before exiting.
+		require.NoError(t, bs.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))))
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			VoluntaryExitsPool: &mock.PoolMock{},
+			Broadcaster:        broadcaster,
+		}
+
+		var body bytes.Buffer
+		_, err := body.WriteString(exit2)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		require.NoError(t, err)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(pendingExits))
+		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{}
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err := body.WriteString(invalidExit1)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{}
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+	})
+	t.Run("wrong signature", func(t *testing.T) {
+		bs, _ := util.DeterministicGenesisState(t, 1)
+		s := &Server{ChainInfoFetcher: &blockchainmock.ChainService{State: bs}}
+
+		var body bytes.Buffer
+		_, err := body.WriteString(invalidExit2)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "Invalid exit"))
+	})
+	t.Run("zero signature", func(t *testing.T) {
+		bs, _ := util.DeterministicGenesisState(t, 1)
+		s := &Server{ChainInfoFetcher: &blockchainmock.ChainService{State: bs}}
+
+		req := &structs.SignedVoluntaryExit{
+			Message:   &structs.VoluntaryExit{Epoch: "0", ValidatorIndex: "0"},
+			Signature: hexutil.Encode(make([]byte, fieldparams.BLSSignatureLength)),
+		}
+		b, err := json.Marshal(req)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(b))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, "Invalid exit: missing signature, the exit must be signed by the validator", e.Message)
+	})
+	t.Run("invalid validator index", func(t *testing.T) {
+		_, keys, err := util.DeterministicDepositsAndKeys(1)
+		require.NoError(t, err)
+		validator := &ethpbv1alpha1.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+			PublicKey: keys[0].PublicKey().Marshal(),
+		}
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = []*ethpbv1alpha1.Validator{validator}
+			return nil
+		})
+		require.NoError(t, err)
+
+		s := &Server{ChainInfoFetcher: &blockchainmock.ChainService{State: bs}}
+
+		var body bytes.Buffer
+		_, err = body.WriteString(invalidExit3)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExit(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, "Could not get validator: validator index 99 is out of bounds, the registry contains 1 validators", e.Message)
+	})
+	t.Run("validator already exiting", func(t *testing.T) {
 		_, keys, err := util.DeterministicDepositsAndKeys(1)
 		require.NoError(t, err)
 		validator := &ethpbv1alpha1.Validator{
-			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+			ExitEpoch: 300,
 			PublicKey: keys[0].PublicKey().Marshal(),
 		}
 		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
@@ -861,119 +4423,358 @@ func TestSubmitVoluntaryExit(t *testing.T) {
 		writer.Body = &bytes.Buffer{}
 
 		s.SubmitVoluntaryExit(writer, request)
-		assert.Equal(t, http.StatusOK, writer.Code)
-		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.StringContains(t, "validator already initiated exit", e.Message)
 		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
 		require.NoError(t, err)
-		require.Equal(t, 1, len(pendingExits))
-		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+		assert.Equal(t, 0, len(pendingExits))
+		assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
 	})
-	t.Run("across fork", func(t *testing.T) {
-		params.SetupTestConfigCleanup(t)
-		config := params.BeaconConfig()
-		config.AltairForkEpoch = params.BeaconConfig().ShardCommitteePeriod + 1
-		params.OverrideBeaconConfig(config)
+}
 
-		bs, _ := util.DeterministicGenesisState(t, 1)
+func TestDeleteVoluntaryExit(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnablePoolExitDeletion: true})
+	defer resetCfg()
+
+	exit1 := &ethpbv1alpha1.SignedVoluntaryExit{
+		Exit:      &ethpbv1alpha1.VoluntaryExit{Epoch: 1, ValidatorIndex: 1},
+		Signature: bytesutil.PadTo([]byte("signature1"), 96),
+	}
+	exit2 := &ethpbv1alpha1.SignedVoluntaryExit{
+		Exit:      &ethpbv1alpha1.VoluntaryExit{Epoch: 2, ValidatorIndex: 2},
+		Signature: bytesutil.PadTo([]byte("signature2"), 96),
+	}
+	root2, err := exit2.HashTreeRoot()
+	require.NoError(t, err)
+
+	deleteExit := func(t *testing.T, s *Server, index, ifMatch string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/prysm/v1/beacon/pool/voluntary_exits/"+index, nil)
+		request.SetPathValue("validator_index", index)
+		if ifMatch != "" {
+			request.Header.Set("If-Match", ifMatch)
+		}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.DeleteVoluntaryExit(writer, request)
+		return writer
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		s := &Server{VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2}}}
+		writer := deleteExit(t, s, "2", "")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.DeleteVoluntaryExitResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, "2", resp.Data.Message.ValidatorIndex)
+		exits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(exits))
+		assert.DeepEqual(t, exit1, exits[0])
+	})
+	t.Run("matching If-Match", func(t *testing.T) {
+		s := &Server{VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2}}}
+		writer := deleteExit(t, s, "2", `"`+hexutil.Encode(root2[:])+`"`)
+		require.Equal(t, http.StatusOK, writer.Code)
+		exits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(exits))
+	})
+	t.Run("mismatching If-Match", func(t *testing.T) {
+		s := &Server{VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2}}}
+		writer := deleteExit(t, s, "1", hexutil.Encode(root2[:]))
+		require.Equal(t, http.StatusPreconditionFailed, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Pending exit does not match the If-Match root", e.Message)
+		exits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(exits))
+	})
+	t.Run("invalid If-Match", func(t *testing.T) {
+		s := &Server{VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2}}}
+		writer := deleteExit(t, s, "1", "0x1234")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "If-Match header must be a 32 byte hex encoded root", e.Message)
+	})
+	t.Run("not found", func(t *testing.T) {
+		s := &Server{VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1}}}
+		writer := deleteExit(t, s, "2", "")
+		require.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No pending exit for validator 2", e.Message)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{})
+		defer resetCfg()
+
+		s := &Server{VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{exit1, exit2}}}
+		writer := deleteExit(t, s, "2", "")
+		require.Equal(t, http.StatusForbidden, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "--enable-pool-exit-deletion", e.Message)
+		exits, err := s.VoluntaryExitsPool.PendingExits()
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(exits))
+	})
+}
+
+func TestGetVoluntaryExitQueue(t *testing.T) {
+	getQueue := func(t *testing.T, s *Server) *structs.VoluntaryExitQueue {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/pool/voluntary_exits/queue", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetVoluntaryExitQueue(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetVoluntaryExitQueueResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		return resp.Data
+	}
+
+	t.Run("phase0", func(t *testing.T) {
+		exitEpoch := helpers.ActivationExitEpoch(0)
+		churnLimit := helpers.ValidatorExitChurnLimit(128)
+		validators := make([]*ethpbv1alpha1.Validator, 128)
+		for i := range validators {
+			validators[i] = &ethpbv1alpha1.Validator{
+				EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+				ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			}
+			if uint64(i) < churnLimit {
+				validators[i].ExitEpoch = exitEpoch
+			}
+		}
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = validators
+			return nil
+		})
+		require.NoError(t, err)
+		s := &Server{ChainInfoFetcher: &blockchainmock.ChainService{State: bs}}
+
+		data := getQueue(t, s)
+		assert.Equal(t, fmt.Sprintf("%d", churnLimit), data.ChurnLimit)
+		assert.Equal(t, "", data.ChurnLimitGwei)
+		assert.Equal(t, fmt.Sprintf("%d", churnLimit), data.QueuedValidators)
+		assert.Equal(t, fmt.Sprintf("%d", exitEpoch+1), data.EarliestExitEpoch)
+	})
+	t.Run("electra", func(t *testing.T) {
+		bs, err := util.NewBeaconStateElectra(func(state *ethpbv1alpha1.BeaconStateElectra) error {
+			state.EarliestExitEpoch = 20
+			return nil
+		})
+		require.NoError(t, err)
+		s := &Server{ChainInfoFetcher: &blockchainmock.ChainService{State: bs}}
+
+		data := getQueue(t, s)
+		assert.Equal(t, "", data.ChurnLimit)
+		assert.Equal(t, fmt.Sprintf("%d", params.BeaconConfig().MinPerEpochChurnLimitElectra), data.ChurnLimitGwei)
+		assert.Equal(t, "0", data.QueuedValidators)
+		assert.Equal(t, "20", data.EarliestExitEpoch)
+	})
+}
+
+func TestSubmitVoluntaryExits(t *testing.T) {
+	transition.SkipSlotCache.Disable()
+	defer transition.SkipSlotCache.Enable()
+
+	_, keys, err := util.DeterministicDepositsAndKeys(1)
+	require.NoError(t, err)
+	validator := &ethpbv1alpha1.Validator{
+		ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		PublicKey: keys[0].PublicKey().Marshal(),
+	}
+	bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+		state.Validators = []*ethpbv1alpha1.Validator{validator}
 		// Satisfy activity time required before exiting.
-		require.NoError(t, bs.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))))
+		state.Slot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod))
+		return nil
+	})
+	require.NoError(t, err)
+	currentSlot := bs.Slot()
+
+	var req structs.SignedVoluntaryExit
+	require.NoError(t, json.Unmarshal([]byte(exit1), &req))
+	exit, err := req.ToConsensus()
+	require.NoError(t, err)
+	sszBytes, err := exit.MarshalSSZ()
+	require.NoError(t, err)
 
+	newRequest := func(t *testing.T, files map[string][]byte, names ...string) *http.Request {
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		require.NoError(t, mw.WriteField("comment", "not an exit"))
+		for _, name := range names {
+			fw, err := mw.CreateFormFile("exits", name)
+			require.NoError(t, err)
+			_, err = fw.Write(files[name])
+			require.NoError(t, err)
+		}
+		require.NoError(t, mw.Close())
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		request.Header.Set("Content-Type", mw.FormDataContentType())
+		return request
+	}
+	files := map[string][]byte{
+		"exit.json": []byte(exit1),
+		"exit.ssz":  sszBytes,
+		"bad.json":  []byte("{}"),
+	}
+
+	t.Run("ok", func(t *testing.T) {
 		broadcaster := &p2pMock.MockBroadcaster{}
 		s := &Server{
 			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			GenesisTimeFetcher: &blockchainmock.ChainService{Slot: &currentSlot},
 			VoluntaryExitsPool: &mock.PoolMock{},
 			Broadcaster:        broadcaster,
 		}
-
-		var body bytes.Buffer
-		_, err := body.WriteString(exit2)
-		require.NoError(t, err)
-		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
-		s.SubmitVoluntaryExit(writer, request)
+		s.SubmitVoluntaryExits(writer, newRequest(t, files, "exit.json", "exit.ssz"))
 		assert.Equal(t, http.StatusOK, writer.Code)
-		require.NoError(t, err)
 		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
 		require.NoError(t, err)
-		require.Equal(t, 1, len(pendingExits))
+		assert.Equal(t, 2, len(pendingExits))
 		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
 	})
-	t.Run("no body", func(t *testing.T) {
-		request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+	t.Run("invalid file", func(t *testing.T) {
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			GenesisTimeFetcher: &blockchainmock.ChainService{Slot: &currentSlot},
+			VoluntaryExitsPool: &mock.PoolMock{},
+			Broadcaster:        &p2pMock.MockBroadcaster{},
+		}
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
-		s := &Server{}
-		s.SubmitVoluntaryExit(writer, request)
+		s.SubmitVoluntaryExits(writer, newRequest(t, files, "exit.json", "bad.json"))
 		assert.Equal(t, http.StatusBadRequest, writer.Code)
-		e := &httputil.DefaultJsonError{}
+		e := &server.IndexedVerificationFailureError{}
 		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-		assert.Equal(t, http.StatusBadRequest, e.Code)
-		assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
-	})
-	t.Run("invalid", func(t *testing.T) {
-		var body bytes.Buffer
-		_, err := body.WriteString(invalidExit1)
+		require.Equal(t, 1, len(e.Failures))
+		assert.Equal(t, 1, e.Failures[0].Index)
+		assert.StringContains(t, "bad.json: could not convert file exit to consensus exit", e.Failures[0].Message)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
 		require.NoError(t, err)
-		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		assert.Equal(t, 1, len(pendingExits))
+	})
+	t.Run("no files", func(t *testing.T) {
+		s := &Server{}
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
-		s := &Server{}
-		s.SubmitVoluntaryExit(writer, request)
+		s.SubmitVoluntaryExits(writer, newRequest(t, files))
 		assert.Equal(t, http.StatusBadRequest, writer.Code)
 		e := &httputil.DefaultJsonError{}
 		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.StringContains(t, "No data submitted", e.Message)
 	})
-	t.Run("wrong signature", func(t *testing.T) {
-		bs, _ := util.DeterministicGenesisState(t, 1)
-		s := &Server{ChainInfoFetcher: &blockchainmock.ChainService{State: bs}}
-
-		var body bytes.Buffer
-		_, err := body.WriteString(invalidExit2)
-		require.NoError(t, err)
-		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+	t.Run("not multipart", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(exit1))
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
-		s.SubmitVoluntaryExit(writer, request)
+		s.SubmitVoluntaryExits(writer, request)
 		assert.Equal(t, http.StatusBadRequest, writer.Code)
-		e := &httputil.DefaultJsonError{}
-		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-		assert.Equal(t, http.StatusBadRequest, e.Code)
-		assert.Equal(t, true, strings.Contains(e.Message, "Invalid exit"))
 	})
-	t.Run("invalid validator index", func(t *testing.T) {
-		_, keys, err := util.DeterministicDepositsAndKeys(1)
+	t.Run("json", func(t *testing.T) {
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			GenesisTimeFetcher: &blockchainmock.ChainService{Slot: &currentSlot},
+			VoluntaryExitsPool: &mock.PoolMock{},
+			Broadcaster:        broadcaster,
+		}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("["+exit1+"]"))
+		request.Header.Set("Content-Type", api.JsonMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExits(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
 		require.NoError(t, err)
-		validator := &ethpbv1alpha1.Validator{
-			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
-			PublicKey: keys[0].PublicKey().Marshal(),
+		assert.Equal(t, 1, len(pendingExits))
+		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+	})
+	t.Run("json invalid exit", func(t *testing.T) {
+		s := &Server{
+			ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+			GenesisTimeFetcher: &blockchainmock.ChainService{Slot: &currentSlot},
+			VoluntaryExitsPool: &mock.PoolMock{},
+			Broadcaster:        &p2pMock.MockBroadcaster{},
 		}
-		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
-			state.Validators = []*ethpbv1alpha1.Validator{validator}
-			return nil
-		})
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("["+exit1+",{}]"))
+		request.Header.Set("Content-Type", api.JsonMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExits(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &server.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 1, len(e.Failures))
+		assert.Equal(t, 1, e.Failures[0].Index)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
 		require.NoError(t, err)
+		assert.Equal(t, 1, len(pendingExits))
+	})
+}
 
-		s := &Server{ChainInfoFetcher: &blockchainmock.ChainService{State: bs}}
+func TestSubmitVoluntaryExits_JSONFailures(t *testing.T) {
+	unsigned := `{"message":{"epoch":"1","validator_index":"2"},"signature":"0x` + strings.Repeat("00", 96) + `"}`
 
-		var body bytes.Buffer
-		_, err = body.WriteString(invalidExit3)
+	future := `{"message":{"epoch":"3","validator_index":"2"},"signature":"0x` + strings.Repeat("01", 96) + `"}`
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 2
+
+	t.Run("every exit invalid", func(t *testing.T) {
+		// The head state is not fetched when no exit is left to verify.
+		s := &Server{
+			GenesisTimeFetcher: &blockchainmock.ChainService{Slot: &currentSlot},
+			VoluntaryExitsPool: &mock.PoolMock{},
+		}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("[{},"+unsigned+","+future+"]"))
+		request.Header.Set("Content-Type", api.JsonMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitVoluntaryExits(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &server.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.Equal(t, 3, len(e.Failures))
+		assert.Equal(t, 0, e.Failures[0].Index)
+		assert.StringContains(t, "Could not convert request exit to consensus exit", e.Failures[0].Message)
+		assert.Equal(t, 1, e.Failures[1].Index)
+		assert.StringContains(t, "missing signature", e.Failures[1].Message)
+		assert.Equal(t, 2, e.Failures[2].Index)
+		assert.StringContains(t, "exit epoch 3 is after the current epoch 2", e.Failures[2].Message)
+		pendingExits, err := s.VoluntaryExitsPool.PendingExits()
 		require.NoError(t, err)
-		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		assert.Equal(t, 0, len(pendingExits))
+	})
+	t.Run("empty", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("[]"))
+		request.Header.Set("Content-Type", api.JsonMediaType)
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
-		s.SubmitVoluntaryExit(writer, request)
+		s.SubmitVoluntaryExits(writer, request)
 		assert.Equal(t, http.StatusBadRequest, writer.Code)
 		e := &httputil.DefaultJsonError{}
 		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-		assert.Equal(t, http.StatusBadRequest, e.Code)
-		assert.Equal(t, true, strings.Contains(e.Message, "Could not get validator"))
+		assert.StringContains(t, "No data submitted", e.Message)
 	})
 }
 
@@ -983,6 +4784,7 @@ func TestSubmitSyncCommitteeSignatures(t *testing.T) {
 	t.Run("single", func(t *testing.T) {
 		broadcaster := &p2pMock.MockBroadcaster{}
 		s := &Server{
+			FinalizationFetcher: &blockchainmock.ChainService{},
 			CoreService: &core.Service{
 				SyncCommitteePool: synccommittee.NewStore(),
 				P2P:               broadcaster,
@@ -1002,61 +4804,128 @@ func TestSubmitSyncCommitteeSignatures(t *testing.T) {
 
 		s.SubmitSyncCommitteeSignatures(writer, request)
 		assert.Equal(t, http.StatusOK, writer.Code)
-		require.NoError(t, err)
+		require.NoError(t, err)
+		msgsInPool, err := s.CoreService.SyncCommitteePool.SyncCommitteeMessages(1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(msgsInPool))
+		assert.Equal(t, primitives.Slot(1), msgsInPool[0].Slot)
+		assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(msgsInPool[0].BlockRoot))
+		assert.Equal(t, primitives.ValidatorIndex(1), msgsInPool[0].ValidatorIndex)
+		assert.Equal(t, "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505", hexutil.Encode(msgsInPool[0].Signature))
+		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+	})
+	t.Run("multiple", func(t *testing.T) {
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			FinalizationFetcher: &blockchainmock.ChainService{},
+			CoreService: &core.Service{
+				SyncCommitteePool: synccommittee.NewStore(),
+				P2P:               broadcaster,
+				HeadFetcher: &blockchainmock.ChainService{
+					State:                st,
+					SyncCommitteeIndices: []primitives.CommitteeIndex{0},
+				},
+			},
+		}
+
+		var body bytes.Buffer
+		_, err := body.WriteString(multipleSyncCommitteeMsg)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitSyncCommitteeSignatures(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		require.NoError(t, err)
+		msgsInPool, err := s.CoreService.SyncCommitteePool.SyncCommitteeMessages(1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(msgsInPool))
+		msgsInPool, err = s.CoreService.SyncCommitteePool.SyncCommitteeMessages(2)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(msgsInPool))
+		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+	})
+	t.Run("duplicates", func(t *testing.T) {
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			FinalizationFetcher: &blockchainmock.ChainService{},
+			CoreService: &core.Service{
+				SyncCommitteePool: synccommittee.NewStore(),
+				P2P:               broadcaster,
+				HeadFetcher: &blockchainmock.ChainService{
+					State:                st,
+					SyncCommitteeIndices: []primitives.CommitteeIndex{0},
+				},
+			},
+		}
+
+		// Submit the same message twice.
+		body := strings.TrimSuffix(strings.TrimSpace(singleSyncCommitteeMsg), "]") + "," + strings.TrimPrefix(strings.TrimSpace(singleSyncCommitteeMsg), "[")
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitSyncCommitteeSignatures(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SubmitSyncCommitteeSignaturesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Duplicates))
+		assert.Equal(t, "1", resp.Duplicates[0].Index)
+		assert.Equal(t, "0", resp.Duplicates[0].DuplicateOf)
 		msgsInPool, err := s.CoreService.SyncCommitteePool.SyncCommitteeMessages(1)
 		require.NoError(t, err)
-		require.Equal(t, 1, len(msgsInPool))
-		assert.Equal(t, primitives.Slot(1), msgsInPool[0].Slot)
-		assert.Equal(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2", hexutil.Encode(msgsInPool[0].BlockRoot))
-		assert.Equal(t, primitives.ValidatorIndex(1), msgsInPool[0].ValidatorIndex)
-		assert.Equal(t, "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505", hexutil.Encode(msgsInPool[0].Signature))
+		assert.Equal(t, 1, len(msgsInPool))
 		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
 	})
-	t.Run("multiple", func(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
 		broadcaster := &p2pMock.MockBroadcaster{}
 		s := &Server{
+			FinalizationFetcher: &blockchainmock.ChainService{},
 			CoreService: &core.Service{
 				SyncCommitteePool: synccommittee.NewStore(),
 				P2P:               broadcaster,
 				HeadFetcher: &blockchainmock.ChainService{
-					State:                st,
-					SyncCommitteeIndices: []primitives.CommitteeIndex{0},
+					State: st,
 				},
 			},
 		}
 
 		var body bytes.Buffer
-		_, err := body.WriteString(multipleSyncCommitteeMsg)
+		_, err := body.WriteString(invalidSyncCommitteeMsg)
 		require.NoError(t, err)
 		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 
 		s.SubmitSyncCommitteeSignatures(writer, request)
-		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
 		require.NoError(t, err)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
 		msgsInPool, err := s.CoreService.SyncCommitteePool.SyncCommitteeMessages(1)
 		require.NoError(t, err)
-		require.Equal(t, 1, len(msgsInPool))
-		msgsInPool, err = s.CoreService.SyncCommitteePool.SyncCommitteeMessages(2)
-		require.NoError(t, err)
-		require.Equal(t, 1, len(msgsInPool))
-		assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
+		assert.Equal(t, 0, len(msgsInPool))
+		assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
 	})
-	t.Run("invalid", func(t *testing.T) {
+	t.Run("unknown block root", func(t *testing.T) {
 		broadcaster := &p2pMock.MockBroadcaster{}
 		s := &Server{
+			// The mock reports every root as unknown to fork choice when NotFinalized is set.
+			FinalizationFetcher: &blockchainmock.ChainService{NotFinalized: true},
 			CoreService: &core.Service{
 				SyncCommitteePool: synccommittee.NewStore(),
 				P2P:               broadcaster,
 				HeadFetcher: &blockchainmock.ChainService{
-					State: st,
+					State:                st,
+					SyncCommitteeIndices: []primitives.CommitteeIndex{0},
 				},
 			},
 		}
 
 		var body bytes.Buffer
-		_, err := body.WriteString(invalidSyncCommitteeMsg)
+		_, err := body.WriteString(singleSyncCommitteeMsg)
 		require.NoError(t, err)
 		request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
 		writer := httptest.NewRecorder()
@@ -1064,10 +4933,11 @@ func TestSubmitSyncCommitteeSignatures(t *testing.T) {
 
 		s.SubmitSyncCommitteeSignatures(writer, request)
 		assert.Equal(t, http.StatusBadRequest, writer.Code)
-		require.NoError(t, err)
-		e := &httputil.DefaultJsonError{}
+		e := &server.IndexedVerificationFailureError{}
 		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
-		assert.Equal(t, http.StatusBadRequest, e.Code)
+		require.Equal(t, 1, len(e.Failures))
+		assert.Equal(t, 0, e.Failures[0].Index)
+		assert.StringContains(t, "is not known to fork choice", e.Failures[0].Message)
 		msgsInPool, err := s.CoreService.SyncCommitteePool.SyncCommitteeMessages(1)
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(msgsInPool))
@@ -1217,6 +5087,9 @@ func TestSubmitSignedBLSToExecutionChanges_Ok(t *testing.T) {
 		OperationNotifier: &blockchainmock.MockOperationNotifier{},
 		BLSChangesPool:    blstoexec.NewPool(),
 	}
+	opChannel := make(chan *feed.Event, 2*numValidators)
+	opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
 	jsonBytes, err := json.Marshal(signedChanges)
 	require.NoError(t, err)
 
@@ -1229,6 +5102,21 @@ func TestSubmitSignedBLSToExecutionChanges_Ok(t *testing.T) {
 	assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
 	assert.Equal(t, numValidators, len(broadcaster.BroadcastMessages))
 
+	var broadcastData *operation.BLSToExecutionChangesBroadcastData
+	for len(opChannel) > 0 {
+		e := <-opChannel
+		if e.Type == operation.BLSToExecutionChangesBroadcast {
+			var ok bool
+			broadcastData, ok = e.Data.(*operation.BLSToExecutionChangesBroadcastData)
+			require.Equal(t, true, ok)
+		}
+	}
+	require.NotNil(t, broadcastData)
+	assert.NotEqual(t, "", writer.Header().Get(api.SubmissionIDHeader))
+	assert.Equal(t, writer.Header().Get(api.SubmissionIDHeader), broadcastData.SubmissionID)
+	assert.Equal(t, numValidators, len(broadcastData.Changes))
+	assert.Equal(t, 0, broadcastData.Remaining)
+
 	poolChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
 	require.Equal(t, len(poolChanges), len(signedChanges))
 	require.NoError(t, err)
@@ -1239,6 +5127,94 @@ func TestSubmitSignedBLSToExecutionChanges_Ok(t *testing.T) {
 	}
 }
 
+// failingMessageBroadcaster fails the broadcast of the failing message.
+type failingMessageBroadcaster struct {
+	p2pMock.MockBroadcaster
+	failing proto.Message
+}
+
+func (b *failingMessageBroadcaster) Broadcast(ctx context.Context, msg proto.Message) error {
+	if proto.Equal(msg, b.failing) {
+		return errors.New("broadcast failed")
+	}
+	return b.MockBroadcaster.Broadcast(ctx, msg)
+}
+
+func TestBroadcastBLSChanges_RateLimit(t *testing.T) {
+	numChanges := 5
+	validators := make([]*ethpbv1alpha1.Validator, numChanges)
+	changes := make([]*ethpbv1alpha1.SignedBLSToExecutionChange, numChanges)
+	for i := range validators {
+		pubkey := bytesutil.PadTo([]byte{byte(i + 1)}, fieldparams.BLSPubkeyLength)
+		hashFn := ssz.NewHasherFunc(hash.CustomSHA256Hasher())
+		digest := hashFn.Hash(pubkey)
+		digest[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+		validators[i] = &ethpbv1alpha1.Validator{
+			PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
+			WithdrawalCredentials: digest[:],
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		changes[i] = &ethpbv1alpha1.SignedBLSToExecutionChange{
+			Message: &ethpbv1alpha1.BLSToExecutionChange{
+				ValidatorIndex:     primitives.ValidatorIndex(i),
+				FromBlsPubkey:      pubkey,
+				ToExecutionAddress: make([]byte, 20),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		}
+	}
+	// The last change no longer matches the withdrawal credentials of its validator.
+	changes[numChanges-1].Message.FromBlsPubkey = make([]byte, fieldparams.BLSPubkeyLength)
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators(validators))
+
+	// The broadcast of the third change, which is the first one of the second batch, fails.
+	broadcaster := &failingMessageBroadcaster{failing: changes[2]}
+	interval := 50 * time.Millisecond
+	s := &Server{
+		ChainInfoFetcher:             &blockchainmock.ChainService{State: st},
+		Broadcaster:                  broadcaster,
+		OperationNotifier:            &blockchainmock.MockOperationNotifier{},
+		BroadcastFailures:            NewBroadcastFailures(),
+		BLSChangesBroadcastRateLimit: 2,
+		BLSChangesBroadcastInterval:  interval,
+	}
+	opChannel := make(chan *feed.Event, numChanges)
+	opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	start := time.Now()
+	submitted := make([]submittedBLSChange, len(changes))
+	for i, ch := range changes {
+		submitted[i] = submittedBLSChange{index: i, change: ch}
+	}
+	s.broadcastBLSChanges(context.Background(), "submission", submitted)
+	assert.Equal(t, true, time.Since(start) >= 2*interval)
+	assert.Equal(t, numChanges-2, broadcaster.NumMessages())
+	failures := s.BroadcastFailures.Recent()
+	require.Equal(t, 1, len(failures))
+	// The index identifies the change in the submission rather than in its batch.
+	assert.Equal(t, 2, failures[0].Index)
+
+	var batches []*operation.BLSToExecutionChangesBroadcastData
+	for len(opChannel) > 0 {
+		e := <-opChannel
+		data, ok := e.Data.(*operation.BLSToExecutionChangesBroadcastData)
+		require.Equal(t, true, ok)
+		batches = append(batches, data)
+	}
+	require.Equal(t, 3, len(batches))
+	for i, want := range []struct{ changes, dropped, failed, remaining int }{{2, 0, 0, 3}, {1, 0, 1, 1}, {0, 1, 0, 0}} {
+		assert.Equal(t, "submission", batches[i].SubmissionID)
+		assert.Equal(t, want.changes, len(batches[i].Changes))
+		assert.Equal(t, want.dropped, len(batches[i].Dropped))
+		assert.Equal(t, want.failed, len(batches[i].Failed))
+		assert.Equal(t, want.remaining, batches[i].Remaining)
+	}
+}
+
 func TestSubmitSignedBLSToExecutionChanges_Bellatrix(t *testing.T) {
 	transition.SkipSlotCache.Disable()
 	defer transition.SkipSlotCache.Enable()
@@ -1443,6 +5419,7 @@ func TestSubmitSignedBLSToExecutionChanges_Failures(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
 	time.Sleep(10 * time.Millisecond) // Delay to allow the routine to start
 	require.StringContains(t, "One or more BLSToExecutionChange failed validation", writer.Body.String())
+	require.StringContains(t, fmt.Sprintf("genesis fork version %#x", params.BeaconConfig().GenesisForkVersion), writer.Body.String())
 	assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
 	assert.Equal(t, numValidators, len(broadcaster.BroadcastMessages)+1)
 
@@ -1456,6 +5433,140 @@ func TestSubmitSignedBLSToExecutionChanges_Failures(t *testing.T) {
 	}
 }
 
+func TestSubmitSignedBLSToExecutionChanges_OutOfBounds(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateCapella(t, 4)
+	chainService := &blockchainmock.ChainService{State: st}
+	s := &Server{
+		ChainInfoFetcher:  chainService,
+		Broadcaster:       &p2pMock.MockBroadcaster{},
+		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+		BLSChangesPool:    blstoexec.NewPool(),
+	}
+
+	change := &structs.SignedBLSToExecutionChange{
+		Message: &structs.BLSToExecutionChange{
+			ValidatorIndex:     "4",
+			FromBLSPubkey:      hexutil.Encode(make([]byte, fieldparams.BLSPubkeyLength)),
+			ToExecutionAddress: hexutil.Encode(make([]byte, 20)),
+		},
+		Signature: hexutil.Encode(make([]byte, fieldparams.BLSSignatureLength)),
+	}
+	jsonBytes, err := json.Marshal([]*structs.SignedBLSToExecutionChange{change})
+	require.NoError(t, err)
+	request := httptest.NewRequest(http.MethodPost, "http://foo.example/eth/v1/beacon/pool/bls_to_execution_changes", bytes.NewReader(jsonBytes))
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.SubmitBLSToExecutionChanges(writer, request)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &server.IndexedVerificationFailureError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	require.Equal(t, 1, len(e.Failures))
+	assert.Equal(t, 0, e.Failures[0].Index)
+	assert.Equal(
+		t,
+		"Could not validate SignedBLSToExecutionChange: validator index 4 is out of bounds, the registry contains 4 validators",
+		e.Failures[0].Message,
+	)
+	poolChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(poolChanges))
+}
+
+func TestSubmitSignedBLSToExecutionChanges_PubkeyMismatch(t *testing.T) {
+	st, keys := util.DeterministicGenesisStateCapella(t, 2)
+	val, err := st.ValidatorAtIndex(0)
+	require.NoError(t, err)
+	digest := hash.Hash(keys[0].PublicKey().Marshal())
+	digest[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+	val.WithdrawalCredentials = digest[:]
+	require.NoError(t, st.UpdateValidatorAtIndex(0, val))
+	chainService := &blockchainmock.ChainService{State: st}
+	s := &Server{
+		ChainInfoFetcher:  chainService,
+		Broadcaster:       &p2pMock.MockBroadcaster{},
+		OperationNotifier: &blockchainmock.MockOperationNotifier{},
+		BLSChangesPool:    blstoexec.NewPool(),
+	}
+
+	change := &structs.SignedBLSToExecutionChange{
+		Message: &structs.BLSToExecutionChange{
+			ValidatorIndex:     "0",
+			FromBLSPubkey:      hexutil.Encode(keys[1].PublicKey().Marshal()),
+			ToExecutionAddress: hexutil.Encode(make([]byte, 20)),
+		},
+		Signature: hexutil.Encode(make([]byte, fieldparams.BLSSignatureLength)),
+	}
+	jsonBytes, err := json.Marshal([]*structs.SignedBLSToExecutionChange{change})
+	require.NoError(t, err)
+	request := httptest.NewRequest(http.MethodPost, "http://foo.example/eth/v1/beacon/pool/bls_to_execution_changes", bytes.NewReader(jsonBytes))
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.SubmitBLSToExecutionChanges(writer, request)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &server.IndexedVerificationFailureError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	require.Equal(t, 1, len(e.Failures))
+	assert.Equal(t, 0, e.Failures[0].Index)
+	assert.Equal(
+		t,
+		"Could not validate SignedBLSToExecutionChange: from_bls_pubkey does not match validator's withdrawal credentials",
+		e.Failures[0].Message,
+	)
+	poolChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(poolChanges))
+}
+
+func TestGetBLSToExecutionChangesStatus(t *testing.T) {
+	st, keys := util.DeterministicGenesisStateCapella(t, 4)
+	// Derive the withdrawal credentials of every validator from its own key.
+	for i, key := range keys {
+		val, err := st.ValidatorAtIndex(primitives.ValidatorIndex(i))
+		require.NoError(t, err)
+		digest := hash.Hash(key.PublicKey().Marshal())
+		digest[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+		val.WithdrawalCredentials = digest[:]
+		require.NoError(t, st.UpdateValidatorAtIndex(primitives.ValidatorIndex(i), val))
+	}
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: st},
+		BLSChangesPool:   blstoexec.NewPool(),
+	}
+	change := func(idx primitives.ValidatorIndex, key common.SecretKey) *ethpbv1alpha1.SignedBLSToExecutionChange {
+		return &ethpbv1alpha1.SignedBLSToExecutionChange{
+			Message: &ethpbv1alpha1.BLSToExecutionChange{
+				ValidatorIndex:     idx,
+				FromBlsPubkey:      key.PublicKey().Marshal(),
+				ToExecutionAddress: make([]byte, 20),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		}
+	}
+	s.BLSChangesPool.InsertBLSToExecChange(change(0, keys[0]))
+	// The public key does not match the withdrawal credentials of the validator.
+	s.BLSChangesPool.InsertBLSToExecChange(change(1, keys[2]))
+	s.BLSChangesPool.InsertBLSToExecChange(change(3, keys[3]))
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetBLSToExecutionChangesStatus(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetBLSToExecutionChangesStatusResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, "2", resp.Data.Valid)
+	assert.Equal(t, "1", resp.Data.Invalid)
+	assert.DeepEqual(t, []string{"1"}, resp.Data.InvalidValidatorIndices)
+	// The pool is not modified.
+	poolChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(poolChanges))
+}
+
 func TestGetAttesterSlashings(t *testing.T) {
 	slashing1PreElectra := &ethpbv1alpha1.AttesterSlashing{
 		Attestation_1: &ethpbv1alpha1.IndexedAttestation{
@@ -1744,6 +5855,54 @@ func TestGetAttesterSlashings(t *testing.T) {
 			require.NoError(t, json.Unmarshal(resp.Data, &slashings))
 			require.Equal(t, 0, len(slashings))
 		})
+		t.Run("group-by-epoch", func(t *testing.T) {
+			bs, err := util.NewBeaconState()
+			require.NoError(t, err)
+
+			s := &Server{
+				ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+				SlashingsPool:    &slashingsmock.PoolMock{PendingAttSlashings: []ethpbv1alpha1.AttSlashing{slashing1PreElectra, slashing2PreElectra}},
+			}
+
+			request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/beacon/pool/attester_slashings?group_by=epoch", nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.GetAttesterSlashingsV2(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			resp := &structs.GetAttesterSlashingsResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			require.NotNil(t, resp.Data)
+
+			var grouped map[string][]*structs.AttesterSlashing
+			require.NoError(t, json.Unmarshal(resp.Data, &grouped))
+			// Each slashing is listed under the target epochs of both of its attestations.
+			require.Equal(t, 4, len(grouped))
+			for epoch, slashing := range map[string]*ethpbv1alpha1.AttesterSlashing{
+				"10": slashing1PreElectra,
+				"20": slashing1PreElectra,
+				"30": slashing2PreElectra,
+				"40": slashing2PreElectra,
+			} {
+				require.Equal(t, 1, len(grouped[epoch]))
+				ss, err := structs.AttesterSlashingsToConsensus(grouped[epoch])
+				require.NoError(t, err)
+				require.DeepEqual(t, slashing, ss[0])
+			}
+		})
+		t.Run("invalid-group-by", func(t *testing.T) {
+			s := &Server{}
+
+			request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/beacon/pool/attester_slashings?group_by=committee", nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.GetAttesterSlashingsV2(writer, request)
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "Invalid group_by value", e.Message)
+		})
 	})
 }
 
@@ -1795,22 +5954,424 @@ func TestGetProposerSlashings(t *testing.T) {
 		},
 	}
 
-	s := &Server{
-		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
-		SlashingsPool:    &slashingsmock.PoolMock{PendingPropSlashings: []*ethpbv1alpha1.ProposerSlashing{slashing1, slashing2}},
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		SlashingsPool:    &slashingsmock.PoolMock{PendingPropSlashings: []*ethpbv1alpha1.ProposerSlashing{slashing1, slashing2}},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/beacon/pool/attester_slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposerSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetProposerSlashingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp)
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, 2, len(resp.Data))
+		headSlot, headRoot, err := headStatePosition(context.Background(), bs)
+		require.NoError(t, err)
+		assert.Equal(t, headSlot, resp.HeadSlot)
+		assert.Equal(t, headRoot, resp.HeadRoot)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/beacon/pool/proposer_slashings", nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposerSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		size := slashing1.SizeSSZ()
+		require.Equal(t, 2*size, writer.Body.Len())
+		got := &ethpbv1alpha1.ProposerSlashing{}
+		require.NoError(t, got.UnmarshalSSZ(writer.Body.Bytes()[size:]))
+		assert.DeepEqual(t, slashing2, got)
+	})
+}
+
+func TestExportPool(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, bs.SetSlot(3))
+	att := util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bitfield.Bitlist{0b11}})
+	committeeBits := primitives.NewAttestationCommitteeBits()
+	committeeBits.SetBitAt(0, true)
+	attElectra := util.HydrateAttestationElectra(&ethpbv1alpha1.AttestationElectra{AggregationBits: bitfield.Bitlist{0b110}, CommitteeBits: committeeBits})
+	attSlashing := &ethpbv1alpha1.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpbv1alpha1.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpbv1alpha1.IndexedAttestation{AttestingIndices: []uint64{1}}),
+	}
+	propSlashing := &ethpbv1alpha1.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpbv1alpha1.SignedBeaconBlockHeader{Header: &ethpbv1alpha1.BeaconBlockHeader{ProposerIndex: 2}}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpbv1alpha1.SignedBeaconBlockHeader{Header: &ethpbv1alpha1.BeaconBlockHeader{ProposerIndex: 2}}),
+	}
+	blsChangesPool := blstoexec.NewPool()
+	blsChangesPool.InsertBLSToExecChange(&ethpbv1alpha1.SignedBLSToExecutionChange{
+		Message: &ethpbv1alpha1.BLSToExecutionChange{
+			ValidatorIndex:     4,
+			FromBlsPubkey:      make([]byte, fieldparams.BLSPubkeyLength),
+			ToExecutionAddress: make([]byte, 20),
+		},
+		Signature: make([]byte, fieldparams.BLSSignatureLength),
+	})
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+		VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{
+			{Exit: &ethpbv1alpha1.VoluntaryExit{ValidatorIndex: 3}, Signature: make([]byte, fieldparams.BLSSignatureLength)},
+		}},
+		BLSChangesPool: blsChangesPool,
+		SlashingsPool: &slashingsmock.PoolMock{
+			PendingAttSlashings:  []ethpbv1alpha1.AttSlashing{attSlashing},
+			PendingPropSlashings: []*ethpbv1alpha1.ProposerSlashing{propSlashing},
+		},
+	}
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{att, attElectra}))
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.ExportPool(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, "attachment; filename=pool_export_3.json", writer.Header().Get("Content-Disposition"))
+	resp := &structs.ExportPoolResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "phase0", resp.Version)
+	assert.Equal(t, "3", resp.HeadSlot)
+	assert.NotEqual(t, "", resp.HeadRoot)
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, 2, len(resp.Data.Attestations))
+	require.Equal(t, 1, len(resp.Data.VoluntaryExits))
+	assert.Equal(t, "3", resp.Data.VoluntaryExits[0].Message.ValidatorIndex)
+	require.Equal(t, 1, len(resp.Data.BLSToExecutionChanges))
+	assert.Equal(t, "4", resp.Data.BLSToExecutionChanges[0].Message.ValidatorIndex)
+	assert.Equal(t, 1, len(resp.Data.AttesterSlashings))
+	require.Equal(t, 1, len(resp.Data.ProposerSlashings))
+	assert.Equal(t, "2", resp.Data.ProposerSlashings[0].SignedHeader1.Message.ProposerIndex)
+}
+
+func TestPruneSlashings(t *testing.T) {
+	ctx := context.Background()
+	bs, keys := util.DeterministicGenesisState(t, 64)
+	pool := slashings.NewPool()
+	for _, idx := range []primitives.ValidatorIndex{1, 2} {
+		slashing, err := util.GenerateProposerSlashingForValidator(bs, keys[idx], idx)
+		require.NoError(t, err)
+		require.NoError(t, pool.InsertProposerSlashing(ctx, bs, slashing))
+	}
+	headState := bs.Copy()
+	val, err := headState.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, headState.UpdateValidatorAtIndex(1, val))
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: headState},
+		SlashingsPool:    pool,
+	}
+	request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.PruneSlashings(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.PruneSlashingsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, "0", resp.Data.AttesterSlashings)
+	assert.Equal(t, "1", resp.Data.ProposerSlashings)
+	pending := pool.PendingProposerSlashings(ctx, bs, true)
+	require.Equal(t, 1, len(pending))
+	assert.Equal(t, primitives.ValidatorIndex(2), pending[0].Header_1.Header.ProposerIndex)
+}
+
+func TestGetSlashingStatus(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	vals := make([]*ethpbv1alpha1.Validator, 4)
+	for i := range vals {
+		vals[i] = &ethpbv1alpha1.Validator{
+			PublicKey:         make([]byte, fieldparams.BLSPubkeyLength),
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	vals[2].Slashed = true
+	vals[3].Slashed = true
+	require.NoError(t, bs.SetValidators(vals))
+
+	proposerSlashing := func(idx primitives.ValidatorIndex) *ethpbv1alpha1.ProposerSlashing {
+		header := func(bodyRoot byte) *ethpbv1alpha1.SignedBeaconBlockHeader {
+			return &ethpbv1alpha1.SignedBeaconBlockHeader{
+				Header: &ethpbv1alpha1.BeaconBlockHeader{
+					ProposerIndex: idx,
+					ParentRoot:    make([]byte, fieldparams.RootLength),
+					StateRoot:     make([]byte, fieldparams.RootLength),
+					BodyRoot:      bytesutil.PadTo([]byte{bodyRoot}, fieldparams.RootLength),
+				},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			}
+		}
+		return &ethpbv1alpha1.ProposerSlashing{Header_1: header(1), Header_2: header(2)}
+	}
+	pendingSlashing := proposerSlashing(0)
+	supersededSlashing := proposerSlashing(1)
+	slashedSlashing := proposerSlashing(2)
+	attSlashing := &ethpbv1alpha1.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpbv1alpha1.IndexedAttestation{AttestingIndices: []uint64{1, 3}}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpbv1alpha1.IndexedAttestation{
+			AttestingIndices: []uint64{1, 3},
+			Data:             &ethpbv1alpha1.AttestationData{BeaconBlockRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength)},
+		}),
+	}
+
+	s := &Server{
+		ChainInfoFetcher:   &blockchainmock.ChainService{State: bs},
+		SlashingsPool:      &slashingsmock.PoolMock{PendingPropSlashings: []*ethpbv1alpha1.ProposerSlashing{pendingSlashing}},
+		SubmittedSlashings: NewSubmittedSlashings(),
+	}
+	roots := make(map[string][32]byte)
+	for name, slashing := range map[string]*ethpbv1alpha1.ProposerSlashing{
+		"pending":    pendingSlashing,
+		"superseded": supersededSlashing,
+		"slashed":    slashedSlashing,
+	} {
+		root, err := slashing.HashTreeRoot()
+		require.NoError(t, err)
+		roots[name] = root
+		s.SubmittedSlashings.Record(root, SubmittedSlashing{
+			Kind:       SlashingKindProposer,
+			Validators: []primitives.ValidatorIndex{slashing.Header_1.Header.ProposerIndex},
+		})
+	}
+	attRoot, err := attSlashing.HashTreeRoot()
+	require.NoError(t, err)
+	s.SubmittedSlashings.Record(attRoot, SubmittedSlashing{Kind: SlashingKindAttester, Validators: []primitives.ValidatorIndex{1, 3}})
+
+	get := func(t *testing.T, root [32]byte) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("slashing_root", hexutil.Encode(root[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetSlashingStatus(writer, request)
+		return writer
+	}
+	status := func(t *testing.T, root [32]byte) *structs.SlashingStatus {
+		writer := get(t, root)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlashingStatusResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, hexutil.Encode(root[:]), resp.Data.Root)
+		return resp.Data
+	}
+
+	t.Run("pending", func(t *testing.T) {
+		data := status(t, roots["pending"])
+		assert.Equal(t, SlashingStatusPending, data.Status)
+		assert.Equal(t, SlashingKindProposer, data.Kind)
+		assert.DeepEqual(t, []string{"0"}, data.Validators)
+		assert.Equal(t, 0, len(data.SlashedValidators))
+	})
+	t.Run("validators slashed", func(t *testing.T) {
+		data := status(t, roots["slashed"])
+		assert.Equal(t, SlashingStatusValidatorsSlashed, data.Status)
+		assert.DeepEqual(t, []string{"2"}, data.SlashedValidators)
+	})
+	t.Run("superseded", func(t *testing.T) {
+		data := status(t, roots["superseded"])
+		assert.Equal(t, SlashingStatusSuperseded, data.Status)
+	})
+	t.Run("attester slashing with some validators slashed", func(t *testing.T) {
+		data := status(t, attRoot)
+		assert.Equal(t, SlashingStatusSuperseded, data.Status)
+		assert.Equal(t, SlashingKindAttester, data.Kind)
+		assert.DeepEqual(t, []string{"1", "3"}, data.Validators)
+		assert.DeepEqual(t, []string{"3"}, data.SlashedValidators)
+	})
+	t.Run("unknown slashing", func(t *testing.T) {
+		writer := get(t, [32]byte{'a'})
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "is not among the recently submitted slashings", e.Message)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("slashing_root", "0xfoo")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetSlashingStatus(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetValidatorPoolStatus(t *testing.T) {
+	helpers.ClearCache()
+	t.Cleanup(helpers.ClearCache)
+	ctx := context.Background()
+	bs, keys := util.DeterministicGenesisState(t, 128)
+	committee, err := helpers.BeaconCommitteeFromState(ctx, bs, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+
+	slashingsPool := slashings.NewPool()
+	attSlashing, err := util.GenerateAttesterSlashingForValidator(bs, keys[12], 12)
+	require.NoError(t, err)
+	require.NoError(t, slashingsPool.InsertAttesterSlashing(ctx, bs, attSlashing))
+	propSlashing, err := util.GenerateProposerSlashingForValidator(bs, keys[13], 13)
+	require.NoError(t, err)
+	require.NoError(t, slashingsPool.InsertProposerSlashing(ctx, bs, propSlashing))
+	blsChangesPool := blstoexec.NewPool()
+	blsChangesPool.InsertBLSToExecChange(&ethpbv1alpha1.SignedBLSToExecutionChange{
+		Message: &ethpbv1alpha1.BLSToExecutionChange{ValidatorIndex: 11},
+	})
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		AttestationsPool: attestations.NewPool(),
+		VoluntaryExitsPool: &mock.PoolMock{Exits: []*ethpbv1alpha1.SignedVoluntaryExit{
+			{Exit: &ethpbv1alpha1.VoluntaryExit{ValidatorIndex: 10}},
+		}},
+		BLSChangesPool: blsChangesPool,
+		SlashingsPool:  slashingsPool,
+	}
+	require.NoError(t, s.AttestationsPool.SaveUnaggregatedAttestations([]ethpbv1alpha1.Att{
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{AggregationBits: bits}),
+		// The committee index is out of range, so the attestation is ignored instead of failing the request.
+		util.HydrateAttestation(&ethpbv1alpha1.Attestation{
+			AggregationBits: bits,
+			Data:            &ethpbv1alpha1.AttestationData{CommitteeIndex: 100},
+		}),
+	}))
+
+	get := func(t *testing.T, query string) *structs.ValidatorPoolStatus {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorPoolStatus(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorPoolStatusResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		return resp.Data
+	}
+
+	t.Run("operations", func(t *testing.T) {
+		status := get(t, "validator_index=10")
+		assert.Equal(t, "10", status.ValidatorIndex)
+		assert.Equal(t, true, status.PendingVoluntaryExit)
+		assert.Equal(t, false, status.PendingBLSToExecutionChange)
+		assert.Equal(t, (*bool)(nil), status.PooledAttestation)
+		assert.Equal(t, true, get(t, "validator_index=11").PendingBLSToExecutionChange)
+		assert.Equal(t, true, get(t, "validator_index=12").PendingAttesterSlashing)
+		status = get(t, "validator_index=13")
+		assert.Equal(t, true, status.PendingProposerSlashing)
+		assert.Equal(t, false, status.PendingAttesterSlashing)
+		assert.Equal(t, false, status.PendingVoluntaryExit)
+	})
+	t.Run("attestations", func(t *testing.T) {
+		status := get(t, fmt.Sprintf("validator_index=%d&include_attestations=true", committee[0]))
+		require.NotNil(t, status.PooledAttestation)
+		assert.Equal(t, true, *status.PooledAttestation)
+		status = get(t, fmt.Sprintf("validator_index=%d&include_attestations=true", committee[1]))
+		require.NotNil(t, status.PooledAttestation)
+		assert.Equal(t, false, *status.PooledAttestation)
+	})
+	t.Run("missing validator index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorPoolStatus(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestHeadStatePosition(t *testing.T) {
+	ctx := context.Background()
+	newHeader := func(stateRoot []byte) *ethpbv1alpha1.BeaconBlockHeader {
+		return &ethpbv1alpha1.BeaconBlockHeader{
+			Slot:       5,
+			ParentRoot: bytesutil.PadTo([]byte("parentroot"), 32),
+			StateRoot:  stateRoot,
+			BodyRoot:   bytesutil.PadTo([]byte("bodyroot"), 32),
+		}
 	}
 
-	request := httptest.NewRequest(http.MethodGet, "http://example.com/beacon/pool/attester_slashings", nil)
-	writer := httptest.NewRecorder()
-	writer.Body = &bytes.Buffer{}
+	t.Run("state root not filled in", func(t *testing.T) {
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Slot = 5
+			state.LatestBlockHeader = newHeader(params.BeaconConfig().ZeroHash[:])
+			return nil
+		})
+		require.NoError(t, err)
+		stateRoot, err := bs.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		blockRoot, err := newHeader(stateRoot[:]).HashTreeRoot()
+		require.NoError(t, err)
 
-	s.GetProposerSlashings(writer, request)
-	require.Equal(t, http.StatusOK, writer.Code)
-	resp := &structs.GetProposerSlashingsResponse{}
-	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
-	require.NotNil(t, resp)
-	require.NotNil(t, resp.Data)
-	assert.Equal(t, 2, len(resp.Data))
+		slot, root, err := headStatePosition(ctx, bs)
+		require.NoError(t, err)
+		assert.Equal(t, "5", slot)
+		assert.Equal(t, hexutil.Encode(blockRoot[:]), root)
+	})
+	t.Run("state advanced past the head block", func(t *testing.T) {
+		stateRoot := bytesutil.PadTo([]byte("stateroot"), 32)
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Slot = 7
+			state.LatestBlockHeader = newHeader(stateRoot)
+			return nil
+		})
+		require.NoError(t, err)
+		blockRoot, err := newHeader(stateRoot).HashTreeRoot()
+		require.NoError(t, err)
+
+		slot, root, err := headStatePosition(ctx, bs)
+		require.NoError(t, err)
+		assert.Equal(t, "7", slot)
+		assert.Equal(t, hexutil.Encode(blockRoot[:]), root)
+	})
+}
+
+func TestAttesterSlashingCondition(t *testing.T) {
+	slashing := func(source1, target1, source2, target2 primitives.Epoch) *ethpbv1alpha1.AttesterSlashing {
+		data := func(source, target primitives.Epoch, root string) *ethpbv1alpha1.AttestationData {
+			return util.HydrateAttestationData(&ethpbv1alpha1.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo([]byte(root), 32),
+				Source:          &ethpbv1alpha1.Checkpoint{Epoch: source},
+				Target:          &ethpbv1alpha1.Checkpoint{Epoch: target},
+			})
+		}
+		return &ethpbv1alpha1.AttesterSlashing{
+			Attestation_1: &ethpbv1alpha1.IndexedAttestation{Data: data(source1, target1, "root1")},
+			Attestation_2: &ethpbv1alpha1.IndexedAttestation{Data: data(source2, target2, "root2")},
+		}
+	}
+
+	condition, err := attesterSlashingCondition(slashing(1, 5, 2, 5))
+	require.NoError(t, err)
+	assert.Equal(t, attesterSlashingDoubleVote, condition)
+	condition, err = attesterSlashingCondition(slashing(1, 5, 2, 4))
+	require.NoError(t, err)
+	assert.Equal(t, attesterSlashingSurroundVote, condition)
+	_, err = attesterSlashingCondition(slashing(2, 4, 1, 5))
+	assert.ErrorContains(t, "the second attestation surrounds the first one", err)
+	_, err = attesterSlashingCondition(slashing(1, 4, 2, 5))
+	assert.ErrorContains(t, "neither a double vote nor a surround vote", err)
+	same := slashing(1, 5, 1, 5)
+	same.Attestation_2.Data = same.Attestation_1.Data
+	_, err = attesterSlashingCondition(same)
+	assert.ErrorContains(t, "the two attestations have the same data", err)
 }
 
 func TestSubmitAttesterSlashings(t *testing.T) {
@@ -1909,6 +6470,66 @@ func TestSubmitAttesterSlashings(t *testing.T) {
 			assert.Equal(t, true, broadcaster.BroadcastCalled.Load())
 			_, ok := broadcaster.BroadcastMessages[0].(*ethpbv1alpha1.AttesterSlashing)
 			assert.Equal(t, true, ok)
+			assert.Equal(t, "", writer.Header().Get(api.VerificationStateSlotHeader))
+			resp := &structs.SubmitAttesterSlashingResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			assert.Equal(t, "double_vote", resp.Condition)
+		})
+		t.Run("head state ahead of slashing", func(t *testing.T) {
+			attestationData1.Slot = 1
+			attestationData2.Slot = 1
+			slashing := &ethpbv1alpha1.AttesterSlashing{
+				Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0},
+					Data:             attestationData1,
+					Signature:        make([]byte, 96),
+				},
+				Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0},
+					Data:             attestationData2,
+					Signature:        make([]byte, 96),
+				},
+			}
+
+			_, keys, err := util.DeterministicDepositsAndKeys(1)
+			require.NoError(t, err)
+			validator := &ethpbv1alpha1.Validator{
+				PublicKey: keys[0].PublicKey().Marshal(),
+			}
+
+			bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+				state.Validators = []*ethpbv1alpha1.Validator{validator}
+				return nil
+			})
+			require.NoError(t, err)
+			require.NoError(t, bs.SetSlot(5))
+
+			for _, att := range []*ethpbv1alpha1.IndexedAttestation{slashing.Attestation_1, slashing.Attestation_2} {
+				sb, err := signing.ComputeDomainAndSign(bs, att.Data.Target.Epoch, att.Data, params.BeaconConfig().DomainBeaconAttester, keys[0])
+				require.NoError(t, err)
+				sig, err := bls.SignatureFromBytes(sb)
+				require.NoError(t, err)
+				att.Signature = sig.Marshal()
+			}
+
+			chainmock := &blockchainmock.ChainService{State: bs}
+			s := &Server{
+				ChainInfoFetcher:  chainmock,
+				SlashingsPool:     &slashingsmock.PoolMock{},
+				Broadcaster:       &p2pMock.MockBroadcaster{},
+				OperationNotifier: chainmock.OperationNotifier(),
+			}
+
+			toSubmit := structs.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+			b, err := json.Marshal(toSubmit[0])
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/attester_slashings", bytes.NewReader(b))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttesterSlashings(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			assert.Equal(t, "5", writer.Header().Get(api.VerificationStateSlotHeader))
 		})
 		t.Run("accross-fork", func(t *testing.T) {
 			attestationData1.Slot = params.BeaconConfig().SlotsPerEpoch
@@ -1998,6 +6619,151 @@ func TestSubmitAttesterSlashings(t *testing.T) {
 			assert.Equal(t, http.StatusBadRequest, e.Code)
 			assert.StringContains(t, "Invalid attester slashing", e.Message)
 		})
+		t.Run("no common validators", func(t *testing.T) {
+			slashing := &ethpbv1alpha1.AttesterSlashing{
+				Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0, 1},
+					Data:             attestationData1,
+					Signature:        make([]byte, 96),
+				},
+				Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{2},
+					Data:             attestationData2,
+					Signature:        make([]byte, 96),
+				},
+			}
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s := &Server{
+				SlashingsPool: &slashingsmock.PoolMock{},
+				Broadcaster:   broadcaster,
+			}
+
+			toSubmit := structs.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+			b, err := json.Marshal(toSubmit[0])
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/attester_slashings", bytes.NewReader(b))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttesterSlashings(writer, request)
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "no common validators between the two attestations; not slashable", e.Message)
+			assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+		})
+		t.Run("neither double nor surround vote", func(t *testing.T) {
+			data2 := attestationData2.Copy()
+			data2.Source.Epoch = 2
+			data2.Target.Epoch = 11
+			slashing := &ethpbv1alpha1.AttesterSlashing{
+				Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0},
+					Data:             attestationData1,
+					Signature:        make([]byte, 96),
+				},
+				Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0},
+					Data:             data2,
+					Signature:        make([]byte, 96),
+				},
+			}
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s := &Server{
+				SlashingsPool: &slashingsmock.PoolMock{},
+				Broadcaster:   broadcaster,
+			}
+
+			toSubmit := structs.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+			b, err := json.Marshal(toSubmit[0])
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/attester_slashings", bytes.NewReader(b))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttesterSlashings(writer, request)
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "neither a double vote nor a surround vote", e.Message)
+			assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+		})
+		t.Run("too many attesting indices", func(t *testing.T) {
+			indices := make([]uint64, params.BeaconConfig().MaxValidatorsPerCommittee+1)
+			for i := range indices {
+				indices[i] = uint64(i)
+			}
+			slashing := &ethpbv1alpha1.AttesterSlashing{
+				Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: indices,
+					Data:             attestationData1,
+					Signature:        make([]byte, 96),
+				},
+				Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0},
+					Data:             attestationData2,
+					Signature:        make([]byte, 96),
+				},
+			}
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s := &Server{
+				SlashingsPool: &slashingsmock.PoolMock{},
+				Broadcaster:   broadcaster,
+			}
+
+			toSubmit := structs.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+			b, err := json.Marshal(toSubmit[0])
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/attester_slashings", bytes.NewReader(b))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttesterSlashings(writer, request)
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, fmt.Sprintf("attestation 1 has %d attesting indices", len(indices)), e.Message)
+			assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+		})
+		t.Run("more attesting indices than validators", func(t *testing.T) {
+			slashing := &ethpbv1alpha1.AttesterSlashing{
+				Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0},
+					Data:             attestationData1,
+					Signature:        make([]byte, 96),
+				},
+				Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+					AttestingIndices: []uint64{0, 1},
+					Data:             attestationData2,
+					Signature:        make([]byte, 96),
+				},
+			}
+			bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+				state.Validators = []*ethpbv1alpha1.Validator{{}}
+				return nil
+			})
+			require.NoError(t, err)
+			broadcaster := &p2pMock.MockBroadcaster{}
+			s := &Server{
+				ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+				SlashingsPool:    &slashingsmock.PoolMock{},
+				Broadcaster:      broadcaster,
+			}
+
+			toSubmit := structs.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+			b, err := json.Marshal(toSubmit[0])
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/attester_slashings", bytes.NewReader(b))
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubmitAttesterSlashings(writer, request)
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.StringContains(t, "attestation 2 has 2 attesting indices, exceeding the maximum of 1", e.Message)
+			assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+		})
 	})
 	t.Run("V2", func(t *testing.T) {
 		t.Run("ok", func(t *testing.T) {
@@ -2185,6 +6951,86 @@ func TestSubmitProposerSlashing_InvalidSlashing(t *testing.T) {
 	assert.StringContains(t, "Invalid proposer slashing", e.Message)
 }
 
+func TestSubmitProposerSlashing_InvalidHeaderSlots(t *testing.T) {
+	t.Run("different slots", func(t *testing.T) {
+		bs, err := util.NewBeaconState()
+		require.NoError(t, err)
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		}
+
+		var req structs.ProposerSlashing
+		require.NoError(t, json.Unmarshal([]byte(invalidProposerSlashing), &req))
+		req.SignedHeader2.Message.Slot = "2"
+		b, err := json.Marshal(req)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/proposer_slashings", bytes.NewReader(b))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitProposerSlashing(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "header slots 1 and 2 are not equal", e.Message)
+	})
+	t.Run("future slot", func(t *testing.T) {
+		bs, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, bs.SetGenesisTime(uint64(time.Now().Unix())))
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		}
+
+		var body bytes.Buffer
+		_, err = body.WriteString(invalidProposerSlashing)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/proposer_slashings", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitProposerSlashing(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "header slot 1 is in the future", e.Message)
+	})
+}
+
+func TestSubmitProposerSlashing_ForkVersionMismatch(t *testing.T) {
+	bs, keys := util.DeterministicGenesisState(t, 64)
+	slashing, err := util.GenerateProposerSlashingForValidator(bs, keys[5], 5)
+	require.NoError(t, err)
+	otherFork := &ethpbv1alpha1.Fork{
+		PreviousVersion: params.BeaconConfig().AltairForkVersion,
+		CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+	}
+	slashing.Header_2.Signature, err = signing.ComputeDomainAndSignWithoutState(
+		otherFork, 0, params.BeaconConfig().DomainBeaconProposer, bs.GenesisValidatorsRoot(), slashing.Header_2.Header, keys[5],
+	)
+	require.NoError(t, err)
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		SlashingsPool:    &slashingsmock.PoolMock{},
+	}
+
+	b, err := json.Marshal(structs.ProposerSlashingFromConsensus(slashing))
+	require.NoError(t, err)
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/proposer_slashings", bytes.NewReader(b))
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.SubmitProposerSlashing(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &httputil.DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(
+		t,
+		fmt.Sprintf("headers are signed under different fork versions %#x and %#x", params.BeaconConfig().GenesisForkVersion, params.BeaconConfig().AltairForkVersion),
+		e.Message,
+	)
+}
+
 var (
 	singleAtt = `[
   {
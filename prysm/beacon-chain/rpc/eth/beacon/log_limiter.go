@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// logLimitInterval is the minimum time between two log lines with the same signature.
+	logLimitInterval = 10 * time.Second
+	// maxLogLimitSignatures defines the max number of distinct log signatures that are tracked.
+	maxLogLimitSignatures = 1024
+	// failureLogLimiter rate limits the log lines of repeated broadcast, save and validation failures.
+	failureLogLimiter = newLogLimiter(logLimitInterval, maxLogLimitSignatures)
+)
+
+type logLimitEntry struct {
+	lastLogged time.Time
+	suppressed int
+}
+
+// logLimiter allows each distinct log line to be logged at most once per interval, so that a misbehaving client
+// does not flood the logs with identical lines while an ongoing problem is still surfaced.
+type logLimiter struct {
+	lock     sync.Mutex
+	interval time.Duration
+	entries  *lru.Cache
+}
+
+func newLogLimiter(interval time.Duration, size int) *logLimiter {
+	return &logLimiter{
+		interval: interval,
+		entries:  lruwrpr.New(size),
+	}
+}
+
+// allow reports whether a log line with the given signature can be logged now.
+// When it can, it also returns the number of identical lines suppressed since the signature was last logged.
+func (l *logLimiter) allow(signature string, now time.Time) (bool, int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	item, ok := l.entries.Get(signature)
+	if !ok {
+		l.entries.Add(signature, &logLimitEntry{lastLogged: now})
+		return true, 0
+	}
+	entry, ok := item.(*logLimitEntry)
+	if !ok {
+		l.entries.Add(signature, &logLimitEntry{lastLogged: now})
+		return true, 0
+	}
+	if now.Sub(entry.lastLogged) < l.interval {
+		entry.suppressed++
+		return false, 0
+	}
+	suppressed := entry.suppressed
+	entry.lastLogged = now
+	entry.suppressed = 0
+	return true, suppressed
+}
+
+// errorCategory returns the type of the error at the root of err's chain of wrapped errors. Unlike the error message,
+// it does not depend on values such as the index or root of the failing object.
+func errorCategory(err error) string {
+	for {
+		var next error
+		switch e := err.(type) {
+		case interface{ Cause() error }:
+			next = e.Cause()
+		case interface{ Unwrap() error }:
+			next = e.Unwrap()
+		}
+		if next == nil {
+			return fmt.Sprintf("%T", err)
+		}
+		err = next
+	}
+}
+
+// logErrorRateLimited logs msg at most once per interval for every distinct message and category of error.
+// The full error and the fields are logged along with the line but are not part of its signature.
+func logErrorRateLimited(fields logrus.Fields, err error, msg string) {
+	ok, suppressed := failureLogLimiter.allow(msg+": "+errorCategory(err), time.Now())
+	if !ok {
+		return
+	}
+	entry := log.WithError(err).WithFields(fields)
+	if suppressed > 0 {
+		entry = entry.WithField("suppressedCount", suppressed)
+	}
+	entry.Error(msg)
+}
@@ -0,0 +1,39 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestLogLimiter(t *testing.T) {
+	l := newLogLimiter(time.Second, 2)
+	now := time.Now()
+
+	ok, suppressed := l.allow("a", now)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 0, suppressed)
+	ok, _ = l.allow("a", now.Add(100*time.Millisecond))
+	assert.Equal(t, false, ok)
+	ok, _ = l.allow("a", now.Add(200*time.Millisecond))
+	assert.Equal(t, false, ok)
+	ok, suppressed = l.allow("b", now.Add(200*time.Millisecond))
+	assert.Equal(t, true, ok, "Distinct signatures must not be limited together")
+	assert.Equal(t, 0, suppressed)
+
+	ok, suppressed = l.allow("a", now.Add(time.Second))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 2, suppressed)
+	ok, _ = l.allow("a", now.Add(1500*time.Millisecond))
+	assert.Equal(t, false, ok)
+}
+
+func TestErrorCategory(t *testing.T) {
+	assert.Equal(t, errorCategory(errors.New("index 1")), errorCategory(errors.Wrap(errors.New("index 2"), "wrapped")))
+	assert.Equal(t, errorCategory(context.DeadlineExceeded), errorCategory(fmt.Errorf("index 3: %w", context.DeadlineExceeded)))
+	assert.NotEqual(t, errorCategory(errors.New("foo")), errorCategory(context.DeadlineExceeded))
+}
@@ -0,0 +1,29 @@
+package beacon
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	listAttestationsTypeMismatchCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "list_attestations_type_mismatch_skipped_count",
+		Help: "The number of pooled attestations skipped by ListAttestations because they are not pre-Electra attestations",
+	})
+	listAttestationsPoolCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "list_attestations_pool_count",
+		Help: "The number of aggregated and unaggregated pooled attestations seen by the last attestation listing request",
+	}, []string{"kind", "version"})
+	listAttestationsReturnedCount = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "list_attestations_returned_count",
+		Help:    "The number of attestations returned by attestation listing requests after filtering",
+		Buckets: []float64{0, 1, 10, 100, 1000, 10000, 100000},
+	}, []string{"version"})
+)
+
+// recordListedAttestations updates the attestation listing metrics, labeled by the version of the listed attestations.
+func recordListedAttestations(v string, aggregated, unaggregated, returned int) {
+	listAttestationsPoolCount.WithLabelValues("aggregated", v).Set(float64(aggregated))
+	listAttestationsPoolCount.WithLabelValues("unaggregated", v).Set(float64(unaggregated))
+	listAttestationsReturnedCount.WithLabelValues(v).Observe(float64(returned))
+}
@@ -0,0 +1,86 @@
+package beacon
+
+import (
+	"bytes"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+)
+
+var (
+	// maxAttestationPoolSnapshots defines the max number of attestation pool snapshots that can be diffed against.
+	maxAttestationPoolSnapshots = 64
+	// maxAttestationPoolSnapshotRoots bounds the number of attestation roots retained across all snapshots.
+	// At 32 bytes per root, snapshots take up to 8 MiB. Snapshots of a pool holding more roots are not retained.
+	maxAttestationPoolSnapshotRoots = 1 << 18
+)
+
+// attestationPoolSnapshot holds the sorted attestation roots of a snapshot.
+type attestationPoolSnapshot [][32]byte
+
+// Contains returns true if the snapshot holds the root.
+func (s attestationPoolSnapshot) Contains(root [32]byte) bool {
+	_, found := slices.BinarySearchFunc(s, root, compareRoots)
+	return found
+}
+
+func compareRoots(a, b [32]byte) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// AttestationPoolSnapshots retains the attestation roots of recently served attestation pool snapshots,
+// keyed by the token returned to the client. Snapshots are shared by all clients, so the least recently used
+// snapshots are evicted once the number of snapshots or the number of retained roots exceeds its limit.
+type AttestationPoolSnapshots struct {
+	lock      sync.Mutex
+	snapshots map[string]attestationPoolSnapshot
+	// tokens holds the tokens of the retained snapshots, from the least to the most recently used.
+	tokens []string
+	roots  int
+}
+
+// NewAttestationPoolSnapshots creates a new cache of attestation pool snapshots.
+func NewAttestationPoolSnapshots() *AttestationPoolSnapshots {
+	return &AttestationPoolSnapshots{
+		snapshots: make(map[string]attestationPoolSnapshot),
+	}
+}
+
+// Save stores the attestation roots of a snapshot and returns the token identifying it.
+// An empty token is returned when the snapshot holds more roots than can be retained.
+func (s *AttestationPoolSnapshots) Save(roots [][32]byte) string {
+	if len(roots) > maxAttestationPoolSnapshotRoots {
+		return ""
+	}
+	snapshot := attestationPoolSnapshot(slices.Clone(roots))
+	slices.SortFunc(snapshot, compareRoots)
+	token := strconv.FormatUint(rand.NewGenerator().Uint64(), 16)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for len(s.tokens) > 0 && (len(s.tokens) >= maxAttestationPoolSnapshots || s.roots+len(snapshot) > maxAttestationPoolSnapshotRoots) {
+		s.roots -= len(s.snapshots[s.tokens[0]])
+		delete(s.snapshots, s.tokens[0])
+		s.tokens = s.tokens[1:]
+	}
+	s.snapshots[token] = snapshot
+	s.tokens = append(s.tokens, token)
+	s.roots += len(snapshot)
+	return token
+}
+
+// Get returns the attestation roots of the snapshot identified by token.
+// The second return value is false when the token is unknown or the snapshot was evicted.
+func (s *AttestationPoolSnapshots) Get(token string) (attestationPoolSnapshot, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	snapshot, ok := s.snapshots[token]
+	if !ok {
+		return nil, false
+	}
+	i := slices.Index(s.tokens, token)
+	s.tokens = append(slices.Delete(s.tokens, i, i+1), token)
+	return snapshot, true
+}
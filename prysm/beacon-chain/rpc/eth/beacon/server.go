@@ -4,6 +4,9 @@
 package beacon
 
 import (
+	"context"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
@@ -18,9 +21,15 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
+// AttestationPacker selects the attestations that the node would include in a block proposed at a slot.
+type AttestationPacker interface {
+	PackAttestations(ctx context.Context, slot primitives.Slot) ([]eth.Att, error)
+}
+
 // Server defines a server implementation of the gRPC Beacon Chain service,
 // providing RPC endpoints to access data relevant to the Ethereum Beacon Chain.
 type Server struct {
@@ -48,4 +57,39 @@ type Server struct {
 	BLSChangesPool          blstoexec.PoolManager
 	ForkchoiceFetcher       blockchain.ForkchoiceFetcher
 	CoreService             *core.Service
+	// AttestationPacker is used by GetAttestationsForInclusion to preview the attestations of the next proposal.
+	AttestationPacker AttestationPacker
+	// AttestationVerificationLevel is the verification level applied to submitted attestations
+	// when the request does not specify one. Requests may only ask for a stricter level.
+	// An empty value results in core.VerificationLevelStandard.
+	AttestationVerificationLevel core.VerificationLevel
+	// AttestationPoolSnapshots retains recent attestation pool snapshots served by GetAttestationPoolDiff.
+	AttestationPoolSnapshots *AttestationPoolSnapshots
+	// BroadcastFailures retains recent broadcast failures served by ListBroadcastFailures.
+	BroadcastFailures *BroadcastFailures
+	// SubmissionRejections counts operations rejected by the submit handlers, served by GetSubmissionRejections.
+	SubmissionRejections *SubmissionRejections
+	// SubmittedSlashings retains recently submitted slashings served by GetSlashingStatus.
+	SubmittedSlashings *SubmittedSlashings
+	// AttestationReceipts retains the outcomes of recent attestation submissions served by GetAttestationReceipt.
+	AttestationReceipts *AttestationReceipts
+	PeersFetcher        p2p.PeersProvider
+	// MinAttestationBroadcastPeers is the minimum number of connected peers required to broadcast submitted attestations.
+	// With fewer peers, attestations are only saved to the pool. A value of 0 disables the check.
+	MinAttestationBroadcastPeers uint64
+	// VerifyAttestationSource enables checking that submitted attestations use a justified checkpoint
+	// known to fork choice as their source.
+	VerifyAttestationSource bool
+	// VerifyAttestationCommittee enables checking that the committee index of submitted attestations
+	// is lower than the committee count per slot.
+	VerifyAttestationCommittee bool
+	// MaxListResponseSize bounds the size in bytes of the data returned by the attestation pool listing endpoints.
+	// A value of 0 disables the limit.
+	MaxListResponseSize uint64
+	// BLSChangesBroadcastRateLimit is the maximum number of submitted BLS to execution changes broadcast per interval.
+	// A value of 0 results in the default of 128.
+	BLSChangesBroadcastRateLimit int
+	// BLSChangesBroadcastInterval is the interval between broadcasts of batches of submitted BLS to execution changes.
+	// A value of 0 results in the default of 500ms.
+	BLSChangesBroadcastInterval time.Duration
 }
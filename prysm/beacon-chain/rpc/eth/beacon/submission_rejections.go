@@ -0,0 +1,123 @@
+package beacon
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server"
+)
+
+var (
+	// submissionRejectionsWindow defines the rolling window over which submission rejections are counted.
+	submissionRejectionsWindow = time.Hour
+	// submissionRejectionsBucket defines the granularity at which rejections fall out of the window.
+	submissionRejectionsBucket = time.Minute
+)
+
+// Categories of rejected submissions.
+const (
+	RejectionCategoryConversion = "conversion"
+	RejectionCategorySignature  = "signature"
+	RejectionCategoryOutOfRange = "out_of_range"
+	RejectionCategoryBroadcast  = "broadcast"
+	RejectionCategoryOther      = "other"
+)
+
+// rejectionCategories lists all rejection categories in the order they are reported.
+var rejectionCategories = []string{
+	RejectionCategoryConversion,
+	RejectionCategorySignature,
+	RejectionCategoryOutOfRange,
+	RejectionCategoryBroadcast,
+	RejectionCategoryOther,
+}
+
+type rejectionBucket struct {
+	start  time.Time
+	counts map[string]uint64
+}
+
+// SubmissionRejections counts rejected submissions per category over a rolling window.
+// Counts are kept in a bounded number of time buckets, the oldest of which is reused once it falls out of the window.
+type SubmissionRejections struct {
+	lock    sync.Mutex
+	buckets []rejectionBucket
+}
+
+// NewSubmissionRejections creates new rejection counters.
+func NewSubmissionRejections() *SubmissionRejections {
+	return &SubmissionRejections{
+		buckets: make([]rejectionBucket, submissionRejectionsWindow/submissionRejectionsBucket),
+	}
+}
+
+// Record adds n rejections of the given category. It is a no-op on nil counters.
+func (r *SubmissionRejections) Record(category string, n int) {
+	r.recordAt(time.Now(), category, n)
+}
+
+// Counts returns the number of rejections per category within the rolling window.
+// Every category is present in the result, including those without rejections.
+func (r *SubmissionRejections) Counts() map[string]uint64 {
+	return r.countsAt(time.Now())
+}
+
+func (r *SubmissionRejections) recordAt(t time.Time, category string, n int) {
+	if r == nil || n <= 0 {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	start := t.Truncate(submissionRejectionsBucket)
+	i := int((start.UnixNano() / int64(submissionRejectionsBucket)) % int64(len(r.buckets)))
+	if !r.buckets[i].start.Equal(start) {
+		r.buckets[i] = rejectionBucket{start: start, counts: make(map[string]uint64)}
+	}
+	r.buckets[i].counts[category] += uint64(n)
+}
+
+func (r *SubmissionRejections) countsAt(t time.Time) map[string]uint64 {
+	counts := make(map[string]uint64, len(rejectionCategories))
+	for _, c := range rejectionCategories {
+		counts[c] = 0
+	}
+	if r == nil {
+		return counts
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	oldest := t.Truncate(submissionRejectionsBucket).Add(-submissionRejectionsWindow)
+	for _, b := range r.buckets {
+		if b.counts == nil || !b.start.After(oldest) || b.start.After(t) {
+			continue
+		}
+		for c, n := range b.counts {
+			counts[c] += n
+		}
+	}
+	return counts
+}
+
+// rejectionCategory classifies a verification failure message into a rejection category.
+func rejectionCategory(message string) string {
+	m := strings.ToLower(message)
+	switch {
+	case strings.Contains(m, "out of bounds"), strings.Contains(m, "out of range"):
+		return RejectionCategoryOutOfRange
+	case strings.Contains(m, "signature"):
+		return RejectionCategorySignature
+	case strings.Contains(m, "convert"), strings.Contains(m, "decode"), strings.Contains(m, "unmarshal"):
+		return RejectionCategoryConversion
+	default:
+		return RejectionCategoryOther
+	}
+}
+
+// recordSubmissionRejections records the rejections of a submission request.
+func (s *Server) recordSubmissionRejections(failures []*server.IndexedVerificationFailure, failedBroadcasts int) {
+	for _, f := range failures {
+		s.SubmissionRejections.Record(rejectionCategory(f.Message), 1)
+	}
+	s.SubmissionRejections.Record(RejectionCategoryBroadcast, failedBroadcasts)
+}
@@ -0,0 +1,72 @@
+package beacon
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// Kinds of submitted slashings.
+const (
+	SlashingKindAttester = "attester"
+	SlashingKindProposer = "proposer"
+)
+
+// Inclusion statuses reported for submitted slashings.
+const (
+	// SlashingStatusPending is reported for slashings that are still in the pool.
+	SlashingStatusPending = "pending"
+	// SlashingStatusValidatorsSlashed is reported for slashings that left the pool once all implicated validators
+	// were slashed in the head state. It does not imply that the submitted slashing itself was included in a block,
+	// as the validators may have been slashed by different evidence.
+	SlashingStatusValidatorsSlashed = "validators_slashed"
+	// SlashingStatusSuperseded is reported for slashings that left the pool while some implicated validators
+	// are not slashed, e.g. because they are no longer slashable.
+	SlashingStatusSuperseded = "superseded"
+)
+
+// maxSubmittedSlashings defines the max number of submitted slashings that are retained.
+var maxSubmittedSlashings = 1024
+
+// SubmittedSlashing describes a slashing submitted through the API.
+type SubmittedSlashing struct {
+	// Kind is either SlashingKindAttester or SlashingKindProposer.
+	Kind string
+	// Validators are the validators implicated by the slashing.
+	Validators []primitives.ValidatorIndex
+}
+
+// SubmittedSlashings retains recent slashings submitted through the API, keyed by their hash tree root.
+// Once full, the least recently used slashing is evicted.
+type SubmittedSlashings struct {
+	cache *lru.Cache
+}
+
+// NewSubmittedSlashings creates a new cache of submitted slashings.
+func NewSubmittedSlashings() *SubmittedSlashings {
+	return &SubmittedSlashings{
+		cache: lruwrpr.New(maxSubmittedSlashings),
+	}
+}
+
+// Record adds a submitted slashing. It is a no-op on a nil cache.
+func (s *SubmittedSlashings) Record(root [32]byte, slashing SubmittedSlashing) {
+	if s == nil {
+		return
+	}
+	s.cache.Add(root, slashing)
+}
+
+// Get returns the submitted slashing with the given root.
+// The second return value is false when the slashing is unknown or was evicted.
+func (s *SubmittedSlashings) Get(root [32]byte) (SubmittedSlashing, bool) {
+	if s == nil {
+		return SubmittedSlashing{}, false
+	}
+	item, ok := s.cache.Get(root)
+	if !ok {
+		return SubmittedSlashing{}, false
+	}
+	slashing, ok := item.(SubmittedSlashing)
+	return slashing, ok
+}
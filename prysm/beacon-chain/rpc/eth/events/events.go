@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	chaintime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
@@ -50,6 +51,8 @@ const (
 	SyncCommitteeContributionTopic = "contribution_and_proof"
 	// BLSToExecutionChangeTopic represents a new received BLS to execution change event topic.
 	BLSToExecutionChangeTopic = "bls_to_execution_change"
+	// BLSToExecutionChangeBroadcastTopic represents a broadcast progress event topic for BLS to execution changes submitted to the node.
+	BLSToExecutionChangeBroadcastTopic = "bls_to_execution_change_broadcast"
 	// PayloadAttributesTopic represents a new payload attributes for execution payload building event topic.
 	PayloadAttributesTopic = "payload_attributes"
 	// BlobSidecarTopic represents a new blob sidecar event topic
@@ -79,9 +82,11 @@ type lazyReader func() io.Reader
 var opsFeedEventTopics = map[feed.EventType]string{
 	operation.AggregatedAttReceived:             AttestationTopic,
 	operation.UnaggregatedAttReceived:           AttestationTopic,
+	operation.UnaggregatedAttsReceived:          AttestationTopic,
 	operation.ExitReceived:                      VoluntaryExitTopic,
 	operation.SyncCommitteeContributionReceived: SyncCommitteeContributionTopic,
 	operation.BLSToExecutionChangeReceived:      BLSToExecutionChangeTopic,
+	operation.BLSToExecutionChangesBroadcast:    BLSToExecutionChangeBroadcastTopic,
 	operation.BlobSidecarReceived:               BlobSidecarTopic,
 	operation.AttesterSlashingReceived:          AttesterSlashingTopic,
 	operation.ProposerSlashingReceived:          ProposerSlashingTopic,
@@ -112,12 +117,20 @@ type topicRequest struct {
 	topics        map[string]bool
 	needStateFeed bool
 	needOpsFeed   bool
+	// unaggregatedAttsOnly restricts the attestation topic to unaggregated attestations.
+	unaggregatedAttsOnly bool
+	// committeeIndices, when not empty, restricts unaggregated attestations to those of the given committees.
+	committeeIndices map[primitives.CommitteeIndex]bool
 }
 
 func (req *topicRequest) requested(topic string) bool {
 	return req.topics[topic]
 }
 
+func (req *topicRequest) attestationRequested(att *eth.Attestation) bool {
+	return len(req.committeeIndices) == 0 || req.committeeIndices[att.GetData().GetCommitteeIndex()]
+}
+
 func newTopicRequest(topics []string) (*topicRequest, error) {
 	req := &topicRequest{topics: make(map[string]bool)}
 	for _, name := range topics {
@@ -151,7 +164,72 @@ func (s *Server) StreamEvents(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	s.streamTopics(ctx, w, topics)
+}
+
+// StreamSlashings provides an endpoint to subscribe to a Server-Sent-Events stream of the attester and proposer
+// slashings accepted by the node. Every event is named after the type of the slashing and carries the slashing itself.
+// It is equivalent to subscribing to the attester_slashing and proposer_slashing topics of StreamEvents.
+func (s *Server) StreamSlashings(w http.ResponseWriter, r *http.Request) {
+	log.Debug("Starting StreamSlashings handler")
+	ctx, span := trace.StartSpan(r.Context(), "events.StreamSlashings")
+	defer span.End()
+
+	topics, err := newTopicRequest([]string{AttesterSlashingTopic, ProposerSlashingTopic})
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.streamTopics(ctx, w, topics)
+}
 
+// StreamBLSToExecutionChanges provides an endpoint to subscribe to a Server-Sent-Events stream of the BLS to execution
+// changes received by the node. Every event carries the signed change, whose message holds the index of the validator.
+// It is equivalent to subscribing to the bls_to_execution_change topic of StreamEvents.
+func (s *Server) StreamBLSToExecutionChanges(w http.ResponseWriter, r *http.Request) {
+	log.Debug("Starting StreamBLSToExecutionChanges handler")
+	ctx, span := trace.StartSpan(r.Context(), "events.StreamBLSToExecutionChanges")
+	defer span.End()
+
+	topics, err := newTopicRequest([]string{BLSToExecutionChangeTopic})
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.streamTopics(ctx, w, topics)
+}
+
+// StreamPoolAttestations provides an endpoint to subscribe to a Server-Sent-Events stream of the unaggregated
+// attestations received by the node, which saves monitoring tools from polling the attestation pool.
+// The optional `committee_index` query parameter, which can be repeated or hold comma-separated values,
+// restricts the stream to attestations of the given committees.
+func (s *Server) StreamPoolAttestations(w http.ResponseWriter, r *http.Request) {
+	log.Debug("Starting StreamPoolAttestations handler")
+	ctx, span := trace.StartSpan(r.Context(), "events.StreamPoolAttestations")
+	defer span.End()
+
+	committeeIndices, ok := shared.UintsFromQuery(w, r, "committee_index")
+	if !ok {
+		return
+	}
+	topics, err := newTopicRequest([]string{AttestationTopic})
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	topics.unaggregatedAttsOnly = true
+	if len(committeeIndices) > 0 {
+		topics.committeeIndices = make(map[primitives.CommitteeIndex]bool, len(committeeIndices))
+		for _, idx := range committeeIndices {
+			topics.committeeIndices[primitives.CommitteeIndex(idx)] = true
+		}
+	}
+	s.streamTopics(ctx, w, topics)
+}
+
+// streamTopics writes the events of the requested topics to the client until the request is done.
+// Feed subscriptions are released when the client disconnects.
+func (s *Server) streamTopics(ctx context.Context, w http.ResponseWriter, topics *topicRequest) {
 	timeout := s.EventWriteTimeout
 	if timeout == 0 {
 		timeout = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
@@ -177,7 +255,7 @@ func (s *Server) StreamEvents(w http.ResponseWriter, r *http.Request) {
 	}
 	cleanupStart := time.Now()
 	es.waitForExit()
-	log.WithField("cleanup_wait", time.Since(cleanupStart)).Debug("streamEvents shutdown complete")
+	log.WithField("cleanup_wait", time.Since(cleanupStart)).Debug("streamTopics shutdown complete")
 }
 
 func newEventStreamer(buffSize int, ka time.Duration) *eventStreamer {
@@ -394,12 +472,16 @@ func topicForEvent(event *feed.Event) string {
 		return AttestationTopic
 	case *operation.UnAggregatedAttReceivedData:
 		return AttestationTopic
+	case *operation.UnAggregatedAttsReceivedData:
+		return AttestationTopic
 	case *operation.ExitReceivedData:
 		return VoluntaryExitTopic
 	case *operation.SyncCommitteeContributionReceivedData:
 		return SyncCommitteeContributionTopic
 	case *operation.BLSToExecutionChangeReceivedData:
 		return BLSToExecutionChangeTopic
+	case *operation.BLSToExecutionChangesBroadcastData:
+		return BLSToExecutionChangeBroadcastTopic
 	case *operation.BlobSidecarReceivedData:
 		return BlobSidecarTopic
 	case *operation.AttesterSlashingReceivedData:
@@ -458,19 +540,58 @@ func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topi
 			return io.MultiReader(headReader(), attrReader())
 		}, nil
 	case *operation.AggregatedAttReceivedData:
-		return func() io.Reader {
-			att := structs.AttFromConsensus(v.Attestation.Aggregate)
-			return jsonMarshalReader(eventName, att)
-		}, nil
+		if topics.unaggregatedAttsOnly {
+			return nil, errNotRequested
+		}
+		switch att := v.Attestation.AggregateVal().(type) {
+		case *eth.Attestation:
+			return func() io.Reader {
+				return jsonMarshalReader(eventName, structs.AttFromConsensus(att))
+			}, nil
+		case *eth.AttestationElectra:
+			return func() io.Reader {
+				return jsonMarshalReader(eventName, structs.AttElectraFromConsensus(att))
+			}, nil
+		default:
+			return nil, errors.Wrapf(errUnhandledEventData, "Unexpected type %T for the .Attestation field of AggregatedAttReceivedData", att)
+		}
 	case *operation.UnAggregatedAttReceivedData:
 		att, ok := v.Attestation.(*eth.Attestation)
 		if !ok {
 			return nil, errors.Wrapf(errUnhandledEventData, "Unexpected type %T for the .Attestation field of UnAggregatedAttReceivedData", v.Attestation)
 		}
+		if !topics.attestationRequested(att) {
+			return nil, errNotRequested
+		}
 		return func() io.Reader {
 			att := structs.AttFromConsensus(att)
 			return jsonMarshalReader(eventName, att)
 		}, nil
+	case *operation.UnAggregatedAttsReceivedData:
+		// Every attestation in the batch is written as a separate event message,
+		// so clients see the same stream as for individually received attestations.
+		atts := make([]*eth.Attestation, 0, len(v.Attestations))
+		for _, a := range v.Attestations {
+			att, ok := a.(*eth.Attestation)
+			if !ok {
+				return nil, errors.Wrapf(errUnhandledEventData, "Unexpected type %T for the .Attestations field of UnAggregatedAttsReceivedData", a)
+			}
+			if topics.attestationRequested(att) {
+				atts = append(atts, att)
+			}
+		}
+		if len(atts) == 0 {
+			return nil, errNotRequested
+		}
+		return func() io.Reader {
+			readers := make([]io.Reader, 0, len(atts))
+			for _, att := range atts {
+				if r := jsonMarshalReader(eventName, structs.AttFromConsensus(att)); r != nil {
+					readers = append(readers, r)
+				}
+			}
+			return io.MultiReader(readers...)
+		}, nil
 	case *operation.ExitReceivedData:
 		return func() io.Reader {
 			return jsonMarshalReader(eventName, structs.SignedExitFromConsensus(v.Exit))
@@ -483,6 +604,24 @@ func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topi
 		return func() io.Reader {
 			return jsonMarshalReader(eventName, structs.SignedBLSChangeFromConsensus(v.Change))
 		}, nil
+	case *operation.BLSToExecutionChangesBroadcastData:
+		return func() io.Reader {
+			validatorIndices := func(changes []*eth.SignedBLSToExecutionChange) []string {
+				indices := make([]string, len(changes))
+				for i, ch := range changes {
+					indices[i] = fmt.Sprintf("%d", ch.Message.ValidatorIndex)
+				}
+				return indices
+			}
+			return jsonMarshalReader(eventName, &structs.BLSToExecutionChangeBroadcastEvent{
+				SubmissionID:            v.SubmissionID,
+				ValidatorIndices:        validatorIndices(v.Changes),
+				DroppedValidatorIndices: validatorIndices(v.Dropped),
+				FailedValidatorIndices:  validatorIndices(v.Failed),
+				Remaining:               fmt.Sprintf("%d", v.Remaining),
+				Complete:                v.Remaining == 0,
+			})
+		}, nil
 	case *operation.BlobSidecarReceivedData:
 		return func() io.Reader {
 			versionedHash := primitives.ConvertKzgCommitmentToVersionedHash(v.Blob.KzgCommitment)
@@ -495,13 +634,18 @@ func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topi
 			})
 		}, nil
 	case *operation.AttesterSlashingReceivedData:
-		slashing, ok := v.AttesterSlashing.(*eth.AttesterSlashing)
-		if !ok {
+		switch slashing := v.AttesterSlashing.(type) {
+		case *eth.AttesterSlashing:
+			return func() io.Reader {
+				return jsonMarshalReader(eventName, structs.AttesterSlashingFromConsensus(slashing))
+			}, nil
+		case *eth.AttesterSlashingElectra:
+			return func() io.Reader {
+				return jsonMarshalReader(eventName, structs.AttesterSlashingElectraFromConsensus(slashing))
+			}, nil
+		default:
 			return nil, errors.Wrapf(errUnhandledEventData, "Unexpected type %T for the .AttesterSlashing field of AttesterSlashingReceivedData", v.AttesterSlashing)
 		}
-		return func() io.Reader {
-			return jsonMarshalReader(eventName, structs.AttesterSlashingFromConsensus(slashing))
-		}, nil
 	case *operation.ProposerSlashingReceivedData:
 		return func() io.Reader {
 			return jsonMarshalReader(eventName, structs.ProposerSlashingFromConsensus(v.ProposerSlashing))
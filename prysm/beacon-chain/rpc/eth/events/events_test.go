@@ -111,6 +111,7 @@ func operationEventsFixtures(t *testing.T) (*topicRequest, []*feed.Event) {
 		VoluntaryExitTopic,
 		SyncCommitteeContributionTopic,
 		BLSToExecutionChangeTopic,
+		BLSToExecutionChangeBroadcastTopic,
 		BlobSidecarTopic,
 		AttesterSlashingTopic,
 		ProposerSlashingTopic,
@@ -127,6 +128,12 @@ func operationEventsFixtures(t *testing.T) (*topicRequest, []*feed.Event) {
 				Attestation: util.HydrateAttestation(&eth.Attestation{}),
 			},
 		},
+		&feed.Event{
+			Type: operation.UnaggregatedAttsReceived,
+			Data: &operation.UnAggregatedAttsReceivedData{
+				Attestations: []eth.Att{util.HydrateAttestation(&eth.Attestation{Data: &eth.AttestationData{Slot: 1}})},
+			},
+		},
 		&feed.Event{
 			Type: operation.AggregatedAttReceived,
 			Data: &operation.AggregatedAttReceivedData{
@@ -181,6 +188,23 @@ func operationEventsFixtures(t *testing.T) (*topicRequest, []*feed.Event) {
 				},
 			},
 		},
+		&feed.Event{
+			Type: operation.BLSToExecutionChangesBroadcast,
+			Data: &operation.BLSToExecutionChangesBroadcastData{
+				SubmissionID: "submission",
+				Changes: []*eth.SignedBLSToExecutionChange{
+					{
+						Message: &eth.BLSToExecutionChange{
+							ValidatorIndex:     0,
+							FromBlsPubkey:      make([]byte, 48),
+							ToExecutionAddress: make([]byte, 20),
+						},
+						Signature: make([]byte, 96),
+					},
+				},
+				Remaining: 0,
+			},
+		},
 		&feed.Event{
 			Type: operation.BlobSidecarReceived,
 			Data: &operation.BlobSidecarReceivedData{
@@ -501,6 +525,224 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 	})
 }
 
+func TestStreamSlashings(t *testing.T) {
+	testSync := newStreamTestSync(t)
+	defer testSync.cleanup()
+	stn := mockChain.NewEventFeedWrapper()
+	opn := mockChain.NewEventFeedWrapper()
+	s := &Server{
+		StateNotifier:     &mockChain.SimpleNotifier{Feed: stn},
+		OperationNotifier: &mockChain.SimpleNotifier{Feed: opn},
+		EventWriteTimeout: testEventWriteTimeout,
+	}
+
+	topics, err := newTopicRequest([]string{AttesterSlashingTopic, ProposerSlashingTopic})
+	require.NoError(t, err)
+	var events []*feed.Event
+	_, opsEvents := operationEventsFixtures(t)
+	for _, ev := range opsEvents {
+		if topics.requested(topicForEvent(ev)) {
+			events = append(events, ev)
+		}
+	}
+	require.Equal(t, 2, len(events))
+	indexedAtt := func() *eth.IndexedAttestationElectra {
+		return &eth.IndexedAttestationElectra{
+			AttestingIndices: []uint64{0, 1},
+			Data: &eth.AttestationData{
+				BeaconBlockRoot: make([]byte, fieldparams.RootLength),
+				Source:          &eth.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+				Target:          &eth.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		}
+	}
+	events = append(events, &feed.Event{
+		Type: operation.AttesterSlashingReceived,
+		Data: &operation.AttesterSlashingReceivedData{
+			AttesterSlashing: &eth.AttesterSlashingElectra{
+				Attestation_1: indexedAtt(),
+				Attestation_2: indexedAtt(),
+			},
+		},
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/events/slashings", nil).WithContext(testSync.ctx)
+	w := NewStreamingResponseWriterRecorder(testSync.ctx)
+
+	go func() {
+		s.StreamSlashings(w, request)
+		testSync.markDone()
+	}()
+
+	requireAllEventsReceived(t, stn, opn, events, topics, s, w, testSync.logs)
+}
+
+func TestStreamBLSToExecutionChanges(t *testing.T) {
+	testSync := newStreamTestSync(t)
+	defer testSync.cleanup()
+	stn := mockChain.NewEventFeedWrapper()
+	opn := mockChain.NewEventFeedWrapper()
+	s := &Server{
+		StateNotifier:     &mockChain.SimpleNotifier{Feed: stn},
+		OperationNotifier: &mockChain.SimpleNotifier{Feed: opn},
+		EventWriteTimeout: testEventWriteTimeout,
+	}
+
+	topics, err := newTopicRequest([]string{BLSToExecutionChangeTopic})
+	require.NoError(t, err)
+	var events []*feed.Event
+	_, opsEvents := operationEventsFixtures(t)
+	for _, ev := range opsEvents {
+		if topics.requested(topicForEvent(ev)) {
+			events = append(events, ev)
+		}
+	}
+	require.Equal(t, 1, len(events))
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/events/bls_to_execution_changes", nil).WithContext(testSync.ctx)
+	w := NewStreamingResponseWriterRecorder(testSync.ctx)
+
+	go func() {
+		s.StreamBLSToExecutionChanges(w, request)
+		testSync.markDone()
+	}()
+
+	requireAllEventsReceived(t, stn, opn, events, topics, s, w, testSync.logs)
+}
+
+func TestStreamPoolAttestations(t *testing.T) {
+	testSync := newStreamTestSync(t)
+	defer testSync.cleanup()
+	stn := mockChain.NewEventFeedWrapper()
+	opn := mockChain.NewEventFeedWrapper()
+	s := &Server{
+		StateNotifier:     &mockChain.SimpleNotifier{Feed: stn},
+		OperationNotifier: &mockChain.SimpleNotifier{Feed: opn},
+		EventWriteTimeout: testEventWriteTimeout,
+	}
+
+	topics, err := newTopicRequest([]string{AttestationTopic})
+	require.NoError(t, err)
+	topics.unaggregatedAttsOnly = true
+	topics.committeeIndices = map[primitives.CommitteeIndex]bool{1: true, 3: true}
+	events := []*feed.Event{
+		{
+			Type: operation.UnaggregatedAttReceived,
+			Data: &operation.UnAggregatedAttReceivedData{
+				Attestation: util.HydrateAttestation(&eth.Attestation{Data: &eth.AttestationData{Slot: 1, CommitteeIndex: 1}}),
+			},
+		},
+		{
+			Type: operation.UnaggregatedAttReceived,
+			Data: &operation.UnAggregatedAttReceivedData{
+				Attestation: util.HydrateAttestation(&eth.Attestation{Data: &eth.AttestationData{Slot: 2, CommitteeIndex: 3}}),
+			},
+		},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/attestations/stream?committee_index=1,3", nil).WithContext(testSync.ctx)
+	w := NewStreamingResponseWriterRecorder(testSync.ctx)
+
+	go func() {
+		s.StreamPoolAttestations(w, request)
+		testSync.markDone()
+	}()
+
+	requireAllEventsReceived(t, stn, opn, events, topics, s, w, testSync.logs)
+}
+
+func TestStreamPoolAttestations_InvalidCommitteeIndex(t *testing.T) {
+	s := &Server{}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/attestations/stream?committee_index=foo", nil)
+	w := httptest.NewRecorder()
+
+	s.StreamPoolAttestations(w, request)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestLazyReaderForEvent_PoolAttestationsFilter(t *testing.T) {
+	topics, err := newTopicRequest([]string{AttestationTopic})
+	require.NoError(t, err)
+	topics.unaggregatedAttsOnly = true
+	topics.committeeIndices = map[primitives.CommitteeIndex]bool{1: true}
+	s := &Server{}
+
+	unaggregated := func(committeeIndex primitives.CommitteeIndex) *eth.Attestation {
+		return util.HydrateAttestation(&eth.Attestation{Data: &eth.AttestationData{CommitteeIndex: committeeIndex}})
+	}
+	_, err = s.lazyReaderForEvent(context.Background(), &feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{Attestation: unaggregated(2)},
+	}, topics)
+	require.ErrorIs(t, err, errNotRequested)
+	_, err = s.lazyReaderForEvent(context.Background(), &feed.Event{
+		Type: operation.AggregatedAttReceived,
+		Data: &operation.AggregatedAttReceivedData{
+			Attestation: &eth.AggregateAttestationAndProof{Aggregate: unaggregated(1), SelectionProof: make([]byte, 96)},
+		},
+	}, topics)
+	require.ErrorIs(t, err, errNotRequested)
+
+	lr, err := s.lazyReaderForEvent(context.Background(), &feed.Event{
+		Type: operation.UnaggregatedAttsReceived,
+		Data: &operation.UnAggregatedAttsReceivedData{
+			Attestations: []eth.Att{unaggregated(1), unaggregated(2), unaggregated(1)},
+		},
+	}, topics)
+	require.NoError(t, err)
+	b, err := io.ReadAll(lr())
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(b), "event: "+AttestationTopic+"\n"))
+}
+
+func TestLazyReaderForEvent_UnaggregatedAttsBatch(t *testing.T) {
+	topics, err := newTopicRequest([]string{AttestationTopic})
+	require.NoError(t, err)
+	s := &Server{}
+	ev := &feed.Event{
+		Type: operation.UnaggregatedAttsReceived,
+		Data: &operation.UnAggregatedAttsReceivedData{
+			Attestations: []eth.Att{
+				util.HydrateAttestation(&eth.Attestation{Data: &eth.AttestationData{Slot: 1}}),
+				util.HydrateAttestation(&eth.Attestation{Data: &eth.AttestationData{Slot: 2}}),
+			},
+		},
+	}
+	lr, err := s.lazyReaderForEvent(context.Background(), ev, topics)
+	require.NoError(t, err)
+	b, err := io.ReadAll(lr())
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(b), "event: "+AttestationTopic+"\n"))
+
+	ev.Data = &operation.UnAggregatedAttsReceivedData{
+		Attestations: []eth.Att{util.HydrateAttestationElectra(&eth.AttestationElectra{})},
+	}
+	_, err = s.lazyReaderForEvent(context.Background(), ev, topics)
+	require.ErrorIs(t, err, errUnhandledEventData)
+}
+
+func TestLazyReaderForEvent_AggregatedAttElectra(t *testing.T) {
+	topics, err := newTopicRequest([]string{AttestationTopic})
+	require.NoError(t, err)
+	s := &Server{}
+	ev := &feed.Event{
+		Type: operation.AggregatedAttReceived,
+		Data: &operation.AggregatedAttReceivedData{
+			Attestation: &eth.AggregateAttestationAndProofElectra{
+				Aggregate:      util.HydrateAttestationElectra(&eth.AttestationElectra{}),
+				SelectionProof: make([]byte, 96),
+			},
+		},
+	}
+	lr, err := s.lazyReaderForEvent(context.Background(), ev, topics)
+	require.NoError(t, err)
+	b, err := io.ReadAll(lr())
+	require.NoError(t, err)
+	require.StringContains(t, "event: "+AttestationTopic+"\n", string(b))
+	require.StringContains(t, `"committee_bits"`, string(b))
+}
+
 func TestStuckReaderScenarios(t *testing.T) {
 	cases := []struct {
 		name       string
@@ -528,7 +770,7 @@ func TestStuckReaderScenarios(t *testing.T) {
 
 func wedgedWriterTestCase(t *testing.T, queueDepth func([]*feed.Event) int) {
 	topics, events := operationEventsFixtures(t)
-	require.Equal(t, 8, len(events))
+	require.Equal(t, 10, len(events))
 
 	// set eventFeedDepth to a number lower than the events we intend to send to force the server to drop the reader.
 	stn := mockChain.NewEventFeedWrapper()
@@ -3,7 +3,9 @@ package shared
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -27,6 +29,25 @@ func UintFromQuery(w http.ResponseWriter, r *http.Request, name string, required
 	return trimmed, v, true
 }
 
+// UintsFromQuery parses all values of a query parameter that can be repeated or hold comma-separated values.
+func UintsFromQuery(w http.ResponseWriter, r *http.Request, name string) ([]uint64, bool) {
+	var values []uint64
+	for _, raw := range r.URL.Query()[name] {
+		trimmed := strings.ReplaceAll(raw, " ", "")
+		if trimmed == "" {
+			continue
+		}
+		for _, s := range strings.Split(trimmed, ",") {
+			v, valid := ValidateUint(w, name, s)
+			if !valid {
+				return nil, false
+			}
+			values = append(values, v)
+		}
+	}
+	return values, true
+}
+
 func UintFromRoute(w http.ResponseWriter, r *http.Request, name string) (string, uint64, bool) {
 	raw := r.PathValue(name)
 	v, valid := ValidateUint(w, name, raw)
@@ -57,6 +78,38 @@ func HexFromRoute(w http.ResponseWriter, r *http.Request, name string, length in
 	return raw, v, true
 }
 
+// DecodeJSONBody decodes the JSON request body into v. An empty body results in a 400 and a body
+// that cannot be decoded into v results in a 422, so that clients can tell both cases apart.
+func DecodeJSONBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted: request body is empty", http.StatusBadRequest)
+		return false
+	case err != nil:
+		httputil.HandleError(w, "Unprocessable JSON: "+err.Error(), http.StatusUnprocessableEntity)
+		return false
+	}
+	return true
+}
+
+func BoolFromQuery(w http.ResponseWriter, r *http.Request, name string) (bool, bool) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return false, true
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		errJson := &httputil.DefaultJsonError{
+			Message: name + " is invalid: " + err.Error(),
+			Code:    http.StatusBadRequest,
+		}
+		httputil.WriteError(w, errJson)
+		return false, false
+	}
+	return v, true
+}
+
 func ValidateHex(w http.ResponseWriter, name, s string, length int) ([]byte, bool) {
 	if s == "" {
 		errJson := &httputil.DefaultJsonError{
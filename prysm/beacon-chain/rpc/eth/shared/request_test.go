@@ -2,7 +2,10 @@ package shared
 
 import (
 	"math"
+	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -94,3 +97,114 @@ func TestUintFromQuery_BuilderBoostFactor(t *testing.T) {
 		})
 	}
 }
+
+func TestUintsFromQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantValues []uint64
+		wantOK     bool
+	}{
+		{
+			name:   "no values",
+			query:  "",
+			wantOK: true,
+		},
+		{
+			name:       "single value",
+			query:      "index=1",
+			wantValues: []uint64{1},
+			wantOK:     true,
+		},
+		{
+			name:       "repeated values",
+			query:      "index=1&index=2",
+			wantValues: []uint64{1, 2},
+			wantOK:     true,
+		},
+		{
+			name:       "comma-separated values",
+			query:      "index=1,%202&index=3",
+			wantValues: []uint64{1, 2, 3},
+			wantOK:     true,
+		},
+		{
+			name:   "invalid value",
+			query:  "index=1,foo",
+			wantOK: false,
+		},
+		{
+			name:   "empty value in list",
+			query:  "index=1,,2",
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/foo?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			got, gotOK := UintsFromQuery(w, req, "index")
+			if gotOK != tt.wantOK {
+				t.Errorf("UintsFromQuery() gotOK = %v, wantOK %v", gotOK, tt.wantOK)
+			}
+			if !reflect.DeepEqual(got, tt.wantValues) {
+				t.Errorf("UintsFromQuery() got = %v, wantValues %v", got, tt.wantValues)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantOK   bool
+		wantCode int
+		wantMsg  string
+	}{
+		{
+			name:     "valid body",
+			body:     `{"value":"1"}`,
+			wantOK:   true,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "empty body",
+			body:     "",
+			wantOK:   false,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "No data submitted: request body is empty",
+		},
+		{
+			name:     "malformed JSON",
+			body:     `{"value":`,
+			wantOK:   false,
+			wantCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:     "wrong type",
+			body:     `{"value":1}`,
+			wantOK:   false,
+			wantCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/foo", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			var v struct {
+				Value string `json:"value"`
+			}
+			gotOK := DecodeJSONBody(w, req, &v)
+			if gotOK != tt.wantOK {
+				t.Errorf("DecodeJSONBody() gotOK = %v, wantOK %v", gotOK, tt.wantOK)
+			}
+			if w.Code != tt.wantCode {
+				t.Errorf("DecodeJSONBody() code = %d, wantCode %d", w.Code, tt.wantCode)
+			}
+			if tt.wantMsg != "" && !strings.Contains(w.Body.String(), tt.wantMsg) {
+				t.Errorf("DecodeJSONBody() body = %s, wantMsg %s", w.Body.String(), tt.wantMsg)
+			}
+		})
+	}
+}
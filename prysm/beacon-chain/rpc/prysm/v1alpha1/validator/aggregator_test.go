@@ -129,7 +129,8 @@ func TestSubmitAggregateAndProof_UnaggregateOk(t *testing.T) {
 	pubKey := v.PublicKey
 	req := &ethpb.AggregateSelectionRequest{CommitteeIndex: 1, SlotSignature: sig.Marshal(), PublicKey: pubKey}
 
-	require.NoError(t, aggregatorServer.AttPool.SaveUnaggregatedAttestation(att0))
+	_, err = aggregatorServer.AttPool.SaveUnaggregatedAttestation(att0)
+	require.NoError(t, err)
 	_, err = aggregatorServer.SubmitAggregateSelectionProof(ctx, req)
 	require.NoError(t, err)
 }
@@ -167,8 +168,11 @@ func TestSubmitAggregateAndProof_AggregateOk(t *testing.T) {
 	pubKey := v.PublicKey
 	req := &ethpb.AggregateSelectionRequest{CommitteeIndex: 1, SlotSignature: sig.Marshal(), PublicKey: pubKey}
 
-	require.NoError(t, aggregatorServer.AttPool.SaveAggregatedAttestation(att0))
-	require.NoError(t, aggregatorServer.AttPool.SaveAggregatedAttestation(att1))
+	_, err = aggregatorServer.AttPool.SaveAggregatedAttestation(att0)
+
+	require.NoError(t, err)
+	_, err = aggregatorServer.AttPool.SaveAggregatedAttestation(att1)
+	require.NoError(t, err)
 	_, err = aggregatorServer.SubmitAggregateSelectionProof(ctx, req)
 	require.NoError(t, err)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
@@ -26,6 +27,29 @@ import (
 
 type proposerAtts []ethpb.Att
 
+// PackAttestations returns the attestations that the node would include in a block proposed at the slot on top of
+// the current head, selected in the same way as during block production. Unlike during block production, pooled
+// attestations that are found to be invalid for inclusion are only left out, they are not removed from the pool.
+func (vs *Server) PackAttestations(ctx context.Context, slot primitives.Slot) ([]ethpb.Att, error) {
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	root := bytesutil.ToBytes32(headRoot)
+	head, err := vs.getParentStateFromReorgData(ctx, slot, root, root, root)
+	if err != nil {
+		return nil, err
+	}
+
+	atts := vs.AttPool.AggregatedAttestations()
+	uAtts, err := vs.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	validAtts, _ := proposerAtts(append(atts, uAtts...)).filter(ctx, head)
+	return vs.selectAttestations(ctx, head, slot, validAtts)
+}
+
 func (vs *Server) packAttestations(ctx context.Context, latestState state.BeaconState, blkSlot primitives.Slot) ([]ethpb.Att, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.packAttestations")
 	defer span.End()
@@ -46,6 +70,11 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	}
 	atts = append(atts, uAtts...)
 
+	return vs.selectAttestations(ctx, latestState, blkSlot, atts)
+}
+
+// selectAttestations aggregates and sorts the valid attestations, and selects those that fit in a block at blkSlot.
+func (vs *Server) selectAttestations(ctx context.Context, latestState state.BeaconState, blkSlot primitives.Slot, atts []ethpb.Att) ([]ethpb.Att, error) {
 	// Checking the state's version here will give the wrong result if the last slot of Deneb is missed.
 	// The head state will still be in Deneb while we are trying to build an Electra block.
 	postElectra := slots.ToEpoch(blkSlot) >= params.BeaconConfig().ElectraForkEpoch
@@ -67,7 +96,7 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 
 	// Remove duplicates from both aggregated/unaggregated attestations. This
 	// prevents inefficient aggregates being created.
-	versionAtts, err = proposerAtts(versionAtts).dedup()
+	versionAtts, err := proposerAtts(versionAtts).dedup()
 	if err != nil {
 		return nil, err
 	}
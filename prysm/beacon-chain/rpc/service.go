@@ -115,6 +115,11 @@ type Config struct {
 	GenesisFetcher            blockchain.GenesisFetcher
 	MockEth1Votes             bool
 	EnableDebugRPCEndpoints   bool
+	AttestationVerification   core.VerificationLevel
+	MinAttBroadcastPeers      uint64
+	VerifyAttSource           bool
+	VerifyAttCommittee        bool
+	MaxListResponseSize       uint64
 	AttestationsPool          attestations.Pool
 	ExitPool                  voluntaryexits.PoolManager
 	SlashingsPool             slashings.PoolManager
@@ -24,10 +24,16 @@ func (s *Service) beaconAggregateProofSubscriber(_ context.Context, msg proto.Me
 		return errors.New("nil aggregate")
 	}
 
+	if err := s.cfg.attPool.SaveAggregatorAttestation(a.AggregateAttestationAndProof().GetAggregatorIndex(), aggregate); err != nil {
+		log.WithError(err).Debug("Could not save aggregator attestation")
+	}
+
 	// An unaggregated attestation can make it here. It’s valid, the aggregator it just itself, although it means poor performance for the subnet.
 	if !helpers.IsAggregated(aggregate) {
-		return s.cfg.attPool.SaveUnaggregatedAttestation(aggregate)
+		_, err := s.cfg.attPool.SaveUnaggregatedAttestation(aggregate)
+		return err
 	}
 
-	return s.cfg.attPool.SaveAggregatedAttestation(aggregate)
+	_, err := s.cfg.attPool.SaveAggregatedAttestation(aggregate)
+	return err
 }
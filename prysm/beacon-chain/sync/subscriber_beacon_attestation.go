@@ -39,7 +39,8 @@ func (s *Service) committeeIndexBeaconAttestationSubscriber(_ context.Context, m
 		return nil
 	}
 
-	return s.cfg.attPool.SaveUnaggregatedAttestation(a)
+	_, err = s.cfg.attPool.SaveUnaggregatedAttestation(a)
+	return err
 }
 
 func (*Service) persistentSubnetIndices() []uint64 {
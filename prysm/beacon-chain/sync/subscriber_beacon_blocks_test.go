@@ -81,9 +81,11 @@ func TestService_beaconBlockSubscriber(t *testing.T) {
 			// Set up attestation pool.
 			for _, att := range pooledAttestations {
 				if helpers.IsAggregated(att) {
-					assert.NoError(t, s.cfg.attPool.SaveAggregatedAttestation(att))
+					_, err := s.cfg.attPool.SaveAggregatedAttestation(att)
+					assert.NoError(t, err)
 				} else {
-					assert.NoError(t, s.cfg.attPool.SaveUnaggregatedAttestation(att))
+					_, err := s.cfg.attPool.SaveUnaggregatedAttestation(att)
+					assert.NoError(t, err)
 				}
 			}
 			// Perform method under test call.
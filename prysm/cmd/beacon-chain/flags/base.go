@@ -209,6 +209,39 @@ var (
 		Name:  "disable-debug-rpc-endpoints",
 		Usage: "Disables the debug Beacon API namespace.",
 	}
+	// AttestationVerificationLevel defines how thoroughly attestations submitted through the Beacon API are verified.
+	AttestationVerificationLevel = &cli.StringFlag{
+		Name: "attestation-verification-level",
+		Usage: "Verification level applied to attestations submitted through the Beacon API. Requests may only select a stricter level. " +
+			"Possible values: `minimal` (structural checks only), `standard` (signature parsing), `strict` (full signature verification).",
+		Value: "standard",
+	}
+	// MinAttestationBroadcastPeers defines the minimum number of connected peers required to broadcast attestations submitted through the Beacon API.
+	MinAttestationBroadcastPeers = &cli.Uint64Flag{
+		Name: "min-attestation-broadcast-peers",
+		Usage: "Minimum number of connected peers required to broadcast attestations submitted through the Beacon API. " +
+			"With fewer peers, submitted attestations are only saved to the pool. A value of 0 disables the check.",
+		Value: 0,
+	}
+	// VerifyAttestationSource enables checking the source checkpoint of attestations submitted through the Beacon API.
+	VerifyAttestationSource = &cli.BoolFlag{
+		Name: "verify-attestation-source",
+		Usage: "Rejects attestations submitted through the Beacon API whose source does not match the current or previous " +
+			"justified checkpoint known to fork choice.",
+	}
+	// VerifyAttestationCommittee enables checking the committee index of attestations submitted through the Beacon API.
+	VerifyAttestationCommittee = &cli.BoolFlag{
+		Name: "verify-attestation-committee",
+		Usage: "Rejects attestations submitted through the Beacon API whose committee index is not lower than the committee " +
+			"count per slot of their epoch in the head state.",
+	}
+	// MaxListResponseSize defines the maximum size of the data returned by the attestation pool listing endpoints.
+	MaxListResponseSize = &cli.Uint64Flag{
+		Name: "max-list-response-size",
+		Usage: "Maximum size in bytes of the attestations returned by a single attestation pool listing request. " +
+			"Larger results are truncated. A value of 0 disables the limit.",
+		Value: 16 * 1024 * 1024,
+	}
 	// SubscribeToAllSubnets defines a flag to specify whether to subscribe to all possible attestation/sync subnets or not.
 	SubscribeToAllSubnets = &cli.BoolFlag{
 		Name:  "subscribe-all-subnets",
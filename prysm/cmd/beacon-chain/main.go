@@ -64,6 +64,11 @@ var appFlags = []cli.Flag{
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
 	flags.DisableDebugRPCEndpoints,
+	flags.AttestationVerificationLevel,
+	flags.MinAttestationBroadcastPeers,
+	flags.VerifyAttestationSource,
+	flags.VerifyAttestationCommittee,
+	flags.MaxListResponseSize,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
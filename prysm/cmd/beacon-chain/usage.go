@@ -116,6 +116,11 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BlobBatchLimit,
 			flags.BlobBatchLimitBurstFactor,
 			flags.DisableDebugRPCEndpoints,
+			flags.AttestationVerificationLevel,
+			flags.MinAttestationBroadcastPeers,
+			flags.VerifyAttestationSource,
+			flags.VerifyAttestationCommittee,
+			flags.MaxListResponseSize,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
@@ -56,6 +56,10 @@ type Flags struct {
 	// Slasher toggles.
 	DisableBroadcastSlashings bool // DisableBroadcastSlashings disables p2p broadcasting of proposer and attester slashings.
 
+	SaveBroadcastFailedAttestations bool // SaveBroadcastFailedAttestations saves submitted attestations that could not be broadcast to the pool.
+	EnablePoolExitDeletion          bool // EnablePoolExitDeletion allows removing pending voluntary exits from the pool through the API.
+	EnableAttestationSourceTagging  bool // EnableAttestationSourceTagging records which pooled attestations were submitted through the API.
+
 	// Bug fixes related flags.
 	AttestTimely bool // AttestTimely fixes #8185. It is gated behind a flag to ensure beacon node's fix can safely roll out first. We'll invert this in v1.1.0.
 
@@ -266,6 +270,18 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(EnableDiscoveryReboot)
 		cfg.EnableDiscoveryReboot = true
 	}
+	if ctx.IsSet(SaveBroadcastFailedAttestations.Name) {
+		logEnabled(SaveBroadcastFailedAttestations)
+		cfg.SaveBroadcastFailedAttestations = true
+	}
+	if ctx.IsSet(EnablePoolExitDeletion.Name) {
+		logEnabled(EnablePoolExitDeletion)
+		cfg.EnablePoolExitDeletion = true
+	}
+	if ctx.IsSet(EnableAttestationSourceTagging.Name) {
+		logEnabled(EnableAttestationSourceTagging)
+		cfg.EnableAttestationSourceTagging = true
+	}
 
 	cfg.AggregateIntervals = [3]time.Duration{aggregateFirstInterval.Value, aggregateSecondInterval.Value, aggregateThirdInterval.Value}
 	Init(cfg)
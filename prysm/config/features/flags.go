@@ -174,6 +174,21 @@ var (
 		Name:  "enable-discovery-reboot",
 		Usage: "Experimental: Enables the discovery listener to rebooted in the event of connectivity issues.",
 	}
+	// SaveBroadcastFailedAttestations keeps submitted attestations that could not be broadcast in the pool.
+	SaveBroadcastFailedAttestations = &cli.BoolFlag{
+		Name:  "save-broadcast-failed-attestations",
+		Usage: "Saves valid attestations submitted through the API to the pool even when they could not be broadcast, so that they can be re-broadcast later.",
+	}
+	// EnablePoolExitDeletion allows removing pending voluntary exits from the pool through the API.
+	EnablePoolExitDeletion = &cli.BoolFlag{
+		Name:  "enable-pool-exit-deletion",
+		Usage: "Enables the admin endpoint removing a pending voluntary exit from the operations pool.",
+	}
+	// EnableAttestationSourceTagging records which pooled attestations were submitted through the API.
+	EnableAttestationSourceTagging = &cli.BoolFlag{
+		Name:  "enable-attestation-source-tagging",
+		Usage: "Records which attestations were submitted through the API, so that the attestation pool endpoints can tell them apart from attestations received on gossip.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -232,6 +247,9 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	EnableQUIC,
 	DisableCommitteeAwarePacking,
 	EnableDiscoveryReboot,
+	SaveBroadcastFailedAttestations,
+	EnablePoolExitDeletion,
+	EnableAttestationSourceTagging,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -56,3 +56,28 @@ func RespondWithSsz(req *http.Request) bool {
 func IsRequestSsz(req *http.Request) bool {
 	return req.Header.Get("Content-Type") == api.OctetStreamMediaType
 }
+
+// RespondWithNdjson checks if the request accepts a stream of newline-delimited JSON values.
+func RespondWithNdjson(req *http.Request) bool {
+	for _, v := range req.Header.Values("Accept") {
+		for _, t := range strings.Split(v, ",") {
+			if strings.TrimSpace(strings.Split(t, ";")[0]) == api.NdjsonMediaType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PreferMinimalResponse checks if the request signals through the `Prefer: return=minimal` header
+// that the client only needs the status code of the response.
+func PreferMinimalResponse(req *http.Request) bool {
+	for _, v := range req.Header.Values("Prefer") {
+		for _, pref := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(pref), "return=minimal") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -121,3 +121,47 @@ func TestIsRequestSsz(t *testing.T) {
 		assert.Equal(t, false, result)
 	})
 }
+
+func TestRespondWithNdjson(t *testing.T) {
+	t.Run("ndjson requested", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/json;q=0.5, application/x-ndjson"}
+		assert.Equal(t, true, RespondWithNdjson(request))
+	})
+
+	t.Run("json requested", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", nil)
+		request.Header["Accept"] = []string{"application/json"}
+		assert.Equal(t, false, RespondWithNdjson(request))
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", nil)
+		assert.Equal(t, false, RespondWithNdjson(request))
+	})
+}
+
+func TestPreferMinimalResponse(t *testing.T) {
+	t.Run("minimal requested", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", nil)
+		request.Header["Prefer"] = []string{"return=minimal"}
+		assert.Equal(t, true, PreferMinimalResponse(request))
+	})
+
+	t.Run("minimal among other preferences", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", nil)
+		request.Header["Prefer"] = []string{"respond-async, Return=Minimal"}
+		assert.Equal(t, true, PreferMinimalResponse(request))
+	})
+
+	t.Run("representation requested", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", nil)
+		request.Header["Prefer"] = []string{"return=representation"}
+		assert.Equal(t, false, PreferMinimalResponse(request))
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", nil)
+		assert.Equal(t, false, PreferMinimalResponse(request))
+	})
+}
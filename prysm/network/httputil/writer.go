@@ -32,8 +32,13 @@ func (e *DefaultJsonError) Error() string {
 
 // WriteJson writes the response message in JSON format.
 func WriteJson(w http.ResponseWriter, v any) {
+	WriteJsonWithStatus(w, http.StatusOK, v)
+}
+
+// WriteJsonWithStatus writes the response message in JSON format with the given status code.
+func WriteJsonWithStatus(w http.ResponseWriter, code int, v any) {
 	w.Header().Set("Content-Type", api.JsonMediaType)
-	w.WriteHeader(http.StatusOK)
+	w.WriteHeader(code)
 	if err := json.NewEncoder(w).Encode(v); err != nil {
 		log.WithError(err).Error("Could not write response message")
 	}